@@ -0,0 +1,94 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/davecgh/go-spew/spew"
+
+	management "github.com/symblai/symbl-go-sdk/pkg/api/management/v1"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	filename := flag.String("f", "account.json", "account config file")
+	restore := flag.Bool("import", false, "import the file instead of exporting to it")
+	conflict := flag.String("conflict", management.ConflictSkip, "skip, overwrite or rename existing definitions")
+	flag.Parse()
+
+	/*
+		Account config
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	mgmtClient := management.New(restClient)
+
+	if !*restore {
+		config, err := mgmtClient.ExportConfig(ctx)
+		if err != nil {
+			fmt.Printf("ExportConfig failed. Err: %v\n", err)
+			os.Exit(1)
+		}
+
+		file, err := os.Create(*filename)
+		if err != nil {
+			fmt.Printf("Create failed. Err: %v\n", err)
+			os.Exit(1)
+		}
+		defer file.Close()
+
+		err = management.WriteAccountConfig(file, config)
+		if err != nil {
+			fmt.Printf("WriteAccountConfig failed. Err: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Exported %d trackers, %d entities and %d groups to %s\n",
+			len(config.Trackers), len(config.Entities), len(config.Groups), *filename)
+		return
+	}
+
+	file, err := os.Open(*filename)
+	if err != nil {
+		fmt.Printf("Open failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	config, err := management.ReadAccountConfig(file)
+	if err != nil {
+		fmt.Printf("ReadAccountConfig failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	result, err := mgmtClient.ImportConfig(ctx, config, management.ImportConfigOpts{
+		Conflict: *conflict,
+	})
+	if err != nil {
+		fmt.Printf("ImportConfig failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n")
+	spew.Dump(result)
+	fmt.Printf("\n")
+
+	fmt.Printf("Succeeded")
+}
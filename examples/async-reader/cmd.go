@@ -0,0 +1,100 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	prettyjson "github.com/hokaccha/go-prettyjson"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	clientinterfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	/*
+		------------------------------------
+		async (reader)
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!\n\n")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	// any io.Reader works. for example, an object storage download or an in-memory buffer
+	byAudio, err := os.ReadFile("../async-file/newPhonecall.mp3")
+	if err != nil {
+		fmt.Printf("os.ReadFile failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	uploadCtx := clientinterfaces.WithUploadProgress(ctx, func(sent, total int64) {
+		fmt.Printf("Uploaded %d of %d bytes\n", sent, total)
+	})
+
+	// the media type is detected from the content
+	jobConvo, err := asyncClient.PostReader(uploadCtx, bytes.NewReader(byAudio), int64(len(byAudio)), "", interfaces.AsyncURLFileRequest{
+		Name: "newPhonecall",
+	})
+	if err == nil {
+		fmt.Printf("JobID: %s, ConversationID: %s\n\n", jobConvo.JobID, jobConvo.ConversationID)
+	} else {
+		fmt.Printf("PostReader failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	completed, err := asyncClient.WaitForJobComplete(ctx, interfaces.WaitForJobStatusOpts{JobId: jobConvo.JobID})
+	if err != nil {
+		fmt.Printf("WaitForJobComplete failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	if !completed {
+		fmt.Printf("WaitForJobComplete failed to complete. Use larger timeout\n")
+		os.Exit(1)
+	}
+
+	messagesResult, err := asyncClient.GetMessages(ctx, jobConvo.ConversationID)
+	if err != nil {
+		fmt.Printf("Messages failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	// print it
+	byData, err := json.Marshal(messagesResult)
+	if err != nil {
+		fmt.Printf("RecognitionResult json.Marshal failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	prettyJson, err := prettyjson.Format(byData)
+	if err != nil {
+		fmt.Printf("prettyjson.Marshal failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("\n\n")
+	fmt.Printf("%s\n", prettyJson)
+	fmt.Printf("\n\n")
+
+	fmt.Printf("Succeeded")
+}
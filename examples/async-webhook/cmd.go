@@ -0,0 +1,85 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+type MyCallback struct{}
+
+func (c MyCallback) JobStatusMessage(js *interfaces.JobStatusEvent) error {
+	fmt.Printf("Job %s is %s\n", js.JobID, js.Status)
+	return nil
+}
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	/*
+		------------------------------------
+		async (url) using a webhook
+		------------------------------------
+	*/
+	// the platform must be able to reach this address, for example through a tunnel
+	webhookURL := os.Getenv("SYMBL_WEBHOOK_URL")
+	if webhookURL == "" {
+		fmt.Printf("SYMBL_WEBHOOK_URL must be set\n")
+		os.Exit(1)
+	}
+
+	webhook := async.NewWebhookHandler(MyCallback{})
+	go func() {
+		err := http.ListenAndServe(":8080", webhook)
+		if err != nil {
+			fmt.Printf("ListenAndServe failed. Err: %v\n", err)
+			os.Exit(1)
+		}
+	}()
+
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	jobConvo, err := asyncClient.PostURLWithOptions(ctx, interfaces.AsyncURLFileRequest{
+		URL:        "https://symbltestdata.s3.us-east-2.amazonaws.com/newPhonecall.mp3",
+		WebhookURL: webhookURL,
+	})
+	if err == nil {
+		fmt.Printf("JobID: %s, ConversationID: %s\n\n", jobConvo.JobID, jobConvo.ConversationID)
+	} else {
+		fmt.Printf("PostURLWithOptions failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	completed, err := asyncClient.WaitForJobCompleteWithWebhook(ctx, webhook, interfaces.WaitForJobStatusOpts{JobId: jobConvo.JobID})
+	if err != nil {
+		fmt.Printf("WaitForJobCompleteWithWebhook failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	if !completed {
+		fmt.Printf("WaitForJobCompleteWithWebhook failed to complete. Use larger timeout\n")
+		os.Exit(1)
+	}
+
+	fmt.Printf("Succeeded")
+}
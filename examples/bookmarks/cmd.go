@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 
@@ -115,6 +116,29 @@ func main() {
 	spew.Dump(updateResponse)
 	fmt.Printf("\n")
 
+	// create from a time range and from a search
+	bookmarkOpts := interfaces.BookmarkOpts{
+		Label: "Pricing",
+		User:  createBookmark.User,
+	}
+	rangeResponse, err := asyncClient.CreateBookmarkFromTimeRange(ctx, conversationId, bookmarkOpts, 10*time.Second, 30*time.Second)
+	if err != nil {
+		fmt.Printf("CreateBookmarkFromTimeRange failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n")
+	spew.Dump(rangeResponse)
+	fmt.Printf("\n")
+
+	searchResults, err := asyncClient.CreateBookmarksFromText(ctx, conversationId, bookmarkOpts, "price")
+	if err != nil && err != async.ErrNoMessagesFound {
+		fmt.Printf("CreateBookmarksFromText failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n")
+	spew.Dump(searchResults)
+	fmt.Printf("\n")
+
 	// list again, again
 	bookmarkResult, err = asyncClient.GetBookmarks(ctx, conversationId)
 	if err != nil {
@@ -125,11 +149,14 @@ func main() {
 	spew.Dump(bookmarkResult)
 	fmt.Printf("\n")
 
-	// delete entities
+	// delete bookmarks
+	bookmarkIds := make([]string, 0, len(bookmarkResult.Bookmarks))
 	for _, bookmark := range bookmarkResult.Bookmarks {
-		err = asyncClient.DeleteBookmark(ctx, conversationId, bookmark.ID)
-		if err != nil {
-			fmt.Printf("DeleteEntity failed. Err: %v\n", err)
+		bookmarkIds = append(bookmarkIds, bookmark.ID)
+	}
+	for _, result := range asyncClient.DeleteBookmarks(ctx, conversationId, bookmarkIds, 0) {
+		if result.Err != nil {
+			fmt.Printf("DeleteBookmark %s failed. Err: %v\n", result.BookmarkID, result.Err)
 			os.Exit(1)
 		}
 	}
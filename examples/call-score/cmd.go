@@ -7,17 +7,19 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"time"
 
 	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
 	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	export "github.com/symblai/symbl-go-sdk/pkg/export"
 )
 
 const (
-	maxRetries     = 20                                          // Maximum number of retries to check for call score status
-	retryInterval  = time.Minute                                 // Time to wait before next retry
-	conversationID = "5740965687197696"                          // A conversation ID
-	newMediaURL    = "https://publicly-accessible-audio-url.mp3" // New media URL for updating insights details
+	totalWaitInSeconds     = 20 * 60                                     // Maximum time to wait for the call score
+	waitInSeconds          = 60                                          // Time to wait before next status check
+	conversationID         = "5740965687197696"                          // A conversation ID
+	baselineConversationID = ""                                          // An optional conversation ID to compare against
+	newMediaURL            = "https://publicly-accessible-audio-url.mp3" // New media URL for updating insights details
 )
 
 func main() {
@@ -37,39 +39,50 @@ func main() {
 
 	asyncClient := async.New(restClient)
 
-	// Check the status of CallScore and wait until it's completed
-	waitForCallScoreCompletion(ctx, asyncClient)
+	// Wait for the CallScore and report it against the baseline
+	reportCallScore(ctx, asyncClient)
 
 	// Subsequent operations and their respective log messages
 	performAsyncClientOperations(ctx, asyncClient)
 }
 
-// waitForCallScoreCompletion waits for the CallScoreStatus to be completed, retrying for defined times and interval.
-func waitForCallScoreCompletion(ctx context.Context, asyncClient *async.Client) {
-	for attempt := 1; attempt <= maxRetries; attempt++ {
-		result, err := asyncClient.GetCallScoreStatusById(ctx, conversationID)
+// reportCallScore waits for the call score and prints it as a Markdown report.
+func reportCallScore(ctx context.Context, asyncClient *async.Client) {
+	opts := interfaces.WaitForCallScoreOpts{
+		TotalWaitInSeconds: totalWaitInSeconds,
+		WaitInSeconds:      waitInSeconds,
+	}
+
+	callScore, err := asyncClient.WaitForCallScore(ctx, conversationID, opts)
+	if err != nil {
+		log.Printf("WaitForCallScore failed. Error: %v\n", err)
+		return
+	}
+
+	var baseline *interfaces.CallScoreResult
+	if baselineConversationID != "" {
+		baseline, err = asyncClient.WaitForCallScore(ctx, baselineConversationID, opts)
 		if err != nil {
-			log.Printf("Error fetching status (attempt %d): %v\n", attempt, err)
-		} else {
-			fmt.Printf("Current status (attempt %d): %s\n", attempt, result.Status)
-			if result.Status == "completed" {
-				fmt.Println("CallScoreStatus is completed!")
-				return
-			}
+			log.Printf("WaitForCallScore for baseline failed. Error: %v\n", err)
 		}
-		time.Sleep(retryInterval)
 	}
-	log.Println("CallScoreStatus did not complete within the maximum retry limit.")
+
+	report, err := export.NewScoreReport(conversationID, callScore, baselineConversationID, baseline)
+	if err != nil {
+		log.Printf("NewScoreReport failed. Error: %v\n", err)
+		return
+	}
+
+	markdown, err := export.ScoreReportToMarkdown(report, export.ReportOpts{})
+	if err != nil {
+		log.Printf("ScoreReportToMarkdown failed. Error: %v\n", err)
+		return
+	}
+	fmt.Printf("\n%s\n", markdown)
 }
 
 // performAsyncClientOperations performs various operations using the asyncClient and logs their outcomes.
 func performAsyncClientOperations(ctx context.Context, asyncClient *async.Client) {
-	if callScore, err := asyncClient.GetCallScore(ctx, conversationID); err != nil {
-		log.Printf("Fetch Call Score failed. Error: %v\n", err)
-	} else {
-		fmt.Printf("Call Score: %v\n", callScore)
-	}
-
 	if insightsListURL, err := asyncClient.GetInsightsListUiURI(ctx); err != nil {
 		log.Printf("Fetch Insights List URL failed. Error: %v\n", err)
 	} else {
@@ -0,0 +1,81 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	export "github.com/symblai/symbl-go-sdk/pkg/export"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	/*
+		------------------------------------
+		async (url) to captions
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	jobConvo, err := asyncClient.PostURL(ctx, "https://symbltestdata.s3.us-east-2.amazonaws.com/newPhonecall.mp3")
+	if err == nil {
+		fmt.Printf("JobID: %s, ConversationID: %s\n\n", jobConvo.JobID, jobConvo.ConversationID)
+	} else {
+		fmt.Printf("PostURL failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	completed, err := asyncClient.WaitForJobComplete(ctx, interfaces.WaitForJobStatusOpts{JobId: jobConvo.JobID})
+	if err != nil {
+		fmt.Printf("WaitForJobComplete failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	if !completed {
+		fmt.Printf("WaitForJobComplete failed to complete. Use larger timeout\n")
+		os.Exit(1)
+	}
+
+	// verbose includes the word timings used to build the cues
+	messagesResult, err := asyncClient.GetMessagesWithOptions(ctx, jobConvo.ConversationID, interfaces.MessagesOptions{
+		Verbose: true,
+	})
+	if err != nil {
+		fmt.Printf("GetMessagesWithOptions failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	vtt, err := export.ToWebVTT(messagesResult, export.CaptionOpts{
+		MaxCharsPerLine: 32,
+		SpeakerLabels:   true,
+	})
+	if err != nil {
+		fmt.Printf("ToWebVTT failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("\n\n")
+	fmt.Printf("%s\n", vtt)
+	fmt.Printf("\n\n")
+
+	fmt.Printf("Succeeded")
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 
@@ -47,10 +48,19 @@ func main() {
 	fmt.Printf("\n")
 
 	// create
+	criteria, err := management.And(
+		management.MetadataEquals("agentId", "johndoe"),
+		management.StartTimeAfter(time.Now().AddDate(0, -1, 0)),
+	).Build()
+	if err != nil {
+		fmt.Printf("Criteria Build failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
 	createRequest := interfaces.Group{
 		Name:        "Test1",
 		Description: "MyDescription1",
-		Criteria:    "conversation.metadata.agentId==johndoe",
+		Criteria:    criteria,
 	}
 
 	createResponse, err := mgmtClient.CreateConversationGroup(ctx, createRequest)
@@ -62,6 +72,16 @@ func main() {
 	spew.Dump(createResponse)
 	fmt.Printf("\n")
 
+	// members
+	conversations, err := mgmtClient.GetConversationGroupConversations(ctx, createResponse.Group.ID)
+	if err != nil {
+		fmt.Printf("GetConversationGroupConversations failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n")
+	spew.Dump(conversations)
+	fmt.Printf("\n")
+
 	// list again
 	groupResult, err = mgmtClient.GetConversationGroups(ctx)
 	if err != nil {
@@ -0,0 +1,75 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	management "github.com/symblai/symbl-go-sdk/pkg/api/management/v1"
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	filename := flag.String("f", "entities.csv", "entity definitions in CSV, YAML or JSON")
+	dryRun := flag.Bool("dry-run", true, "only print the plan")
+	prune := flag.Bool("prune", false, "delete entities which are not defined in the file")
+	flag.Parse()
+
+	/*
+		Entity sync
+	*/
+	file, err := os.Open(*filename)
+	if err != nil {
+		fmt.Printf("Open failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	var desired []mgmtinterfaces.EntityRequest
+	if strings.HasSuffix(*filename, ".csv") {
+		desired, err = management.LoadEntitiesCSV(file)
+	} else {
+		desired, err = management.LoadEntities(file)
+	}
+	if err != nil {
+		fmt.Printf("Load failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	mgmtClient := management.New(restClient)
+
+	plan, err := mgmtClient.SyncEntities(ctx, desired, management.SyncOpts{
+		DryRun: *dryRun,
+		Prune:  *prune,
+	})
+	if plan != nil {
+		fmt.Printf("\n%s\n", plan)
+	}
+	if err != nil {
+		fmt.Printf("SyncEntities failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Succeeded")
+}
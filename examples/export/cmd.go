@@ -0,0 +1,85 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	export "github.com/symblai/symbl-go-sdk/pkg/export"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	if len(os.Args) < 2 {
+		fmt.Printf("Usage: %s <conversationId>\n", os.Args[0])
+		os.Exit(1)
+	}
+	conversationId := os.Args[1]
+
+	/*
+		------------------------------------
+		export a conversation
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	bundle, err := export.NewBundle(ctx, asyncClient, conversationId)
+	if err != nil {
+		fmt.Printf("NewBundle failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	if len(bundle.Missing) > 0 {
+		fmt.Printf("Insights not exported: %v\n", bundle.Missing)
+	}
+
+	byJSON, err := export.ToJSON(bundle)
+	if err != nil {
+		fmt.Printf("ToJSON failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	byMarkdown, err := export.ToMarkdown(bundle, export.ReportOpts{})
+	if err != nil {
+		fmt.Printf("ToMarkdown failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	byHTML, err := export.ToHTML(bundle, export.ReportOpts{})
+	if err != nil {
+		fmt.Printf("ToHTML failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	files := map[string][]byte{
+		conversationId + ".json": byJSON,
+		conversationId + ".md":   byMarkdown,
+		conversationId + ".html": byHTML,
+	}
+	for name, data := range files {
+		err = os.WriteFile(name, data, 0644)
+		if err != nil {
+			fmt.Printf("WriteFile failed. Err: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Printf("Wrote %s\n", name)
+	}
+
+	fmt.Printf("Succeeded")
+}
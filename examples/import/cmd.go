@@ -0,0 +1,72 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	export "github.com/symblai/symbl-go-sdk/pkg/export"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	if len(os.Args) < 2 {
+		fmt.Printf("Usage: %s <bundle.json>\n", os.Args[0])
+		os.Exit(1)
+	}
+
+	/*
+		------------------------------------
+		import an exported conversation
+		------------------------------------
+	*/
+	file, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Printf("Open failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	bundle, err := export.ReadBundle(file)
+	if err != nil {
+		fmt.Printf("ReadBundle failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	result, err := export.Import(ctx, asyncClient, bundle, export.ImportOpts{})
+	if err != nil {
+		fmt.Printf("Import failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	for _, err := range result.Errors {
+		fmt.Printf("Import warning. Err: %v\n", err)
+	}
+
+	fmt.Printf("ConversationID: %s -> %s\n", result.OldConversationID, result.NewConversationID)
+	for oldId, newId := range result.BookmarkIDs {
+		fmt.Printf("BookmarkID: %s -> %s\n", oldId, newId)
+	}
+
+	fmt.Printf("Succeeded")
+}
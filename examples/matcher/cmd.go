@@ -0,0 +1,73 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	prettyjson "github.com/hokaccha/go-prettyjson"
+
+	management "github.com/symblai/symbl-go-sdk/pkg/api/management/v1"
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+	matcher "github.com/symblai/symbl-go-sdk/pkg/matcher"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	filename := flag.String("f", "../trackers-sync/trackers.yaml", "tracker definitions in YAML or JSON")
+	text := flag.String("text", "Is there a discount if we sign up for a year? The price increased last time.", "text to match")
+	flag.Parse()
+
+	/*
+		Local tracker matching
+	*/
+	file, err := os.Open(*filename)
+	if err != nil {
+		fmt.Printf("Open failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	definitions, err := management.LoadTrackers(file)
+	if err != nil {
+		fmt.Printf("LoadTrackers failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	trackers := make([]mgmtinterfaces.Tracker, 0, len(definitions))
+	for _, definition := range definitions {
+		trackers = append(trackers, mgmtinterfaces.Tracker{
+			Name:       definition.Name,
+			Categories: definition.Categories,
+			Languages:  definition.Languages,
+			Vocabulary: definition.Vocabulary,
+		})
+	}
+
+	m := matcher.New(trackers, nil, matcher.MatcherOpts{})
+	result := m.MatchTrackersText(*text)
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		fmt.Printf("json.Marshal failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	prettyJson, err := prettyjson.Format(data)
+	if err != nil {
+		fmt.Printf("prettyjson.Format failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("\n\n%s\n\n", prettyJson)
+
+	fmt.Printf("Matched %d of %d trackers\n", len(result), len(trackers))
+}
@@ -15,7 +15,7 @@ import (
 	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
 	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
 	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
-	cfginterfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
+	redaction "github.com/symblai/symbl-go-sdk/pkg/redaction"
 )
 
 func main() {
@@ -66,21 +66,32 @@ func main() {
 		os.Exit(1)
 	}
 
-	// custom headers to enable options
-	params := make(map[string][]string, 0)
-	// params["exclude"] = []string{"[\"PERSON_NAME\"]"}
-	// params["exclude"] = []string{"[\"PHONE_NUMBER\"]"}
-	// params["exclude"] = []string{"[\"PERSON_NAME\"]", "[\"PHONE_NUMBER\"]"}
-	params["exclude"] = []string{"\"PERSON_NAME\"", "\"PHONE_NUMBER\""}
-	params["redact"] = []string{"true"}
-	ctx = cfginterfaces.WithCustomParameters(ctx, params)
-
-	messagesResult, err := asyncClient.GetMessages(ctx, jobConvo.ConversationID)
+	// platform redaction
+	messagesResult, err := asyncClient.GetMessagesWithOptions(ctx, jobConvo.ConversationID, interfaces.MessagesOptions{
+		Redaction: interfaces.RedactionOptions{
+			Redact:           true,
+			Exclude:          []string{"PERSON_NAME", "PHONE_NUMBER"},
+			ReplacementToken: "[REDACTED]",
+		},
+	})
 	if err != nil {
 		fmt.Printf("Messages failed. Err: %v\n", err)
 		os.Exit(1)
 	}
 
+	// local redaction of the remaining entities
+	entitiesResult, err := asyncClient.GetEntities(ctx, jobConvo.ConversationID)
+	if err != nil {
+		fmt.Printf("GetEntities failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	redactor := redaction.New(entitiesResult, redaction.RedactorOpts{
+		ReplacementToken: "[{type}]",
+		AllOccurrences:   true,
+	})
+	messagesResult = redactor.RedactMessages(messagesResult)
+
 	// print it
 	byData, err := json.Marshal(messagesResult)
 	if err != nil {
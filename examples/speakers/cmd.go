@@ -0,0 +1,76 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	if len(os.Args) < 2 {
+		fmt.Printf("Usage: %s <conversationId>\n", os.Args[0])
+		os.Exit(1)
+	}
+
+	/*
+		------------------------------------
+		assign speakers from a roster
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	// roster and segments as reported by the call recording system
+	request := interfaces.AssignSpeakersRequest{
+		Roster: []interfaces.RosterEntry{
+			{Speaker: "agent", Name: "John Doe", Email: "john@example.com"},
+			{Speaker: "customer", Name: "Jane Smith", Email: "jane@example.com"},
+		},
+		Segments: []interfaces.SpeakerSegment{
+			{Speaker: "agent", Start: 0, End: 12 * time.Second},
+			{Speaker: "customer", Start: 12 * time.Second, End: 30 * time.Second},
+			{Speaker: "agent", Start: 30 * time.Second, End: 45 * time.Second},
+		},
+	}
+
+	result, err := asyncClient.AssignSpeakers(ctx, os.Args[1], request)
+	if err != nil {
+		fmt.Printf("AssignSpeakers failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	for _, err := range result.Errors {
+		fmt.Printf("AssignSpeakers warning. Err: %v\n", err)
+	}
+
+	fmt.Printf("Sent %d speaker events\n", result.Events)
+	for _, member := range result.Updated {
+		fmt.Printf("Member %s: %s <%s>\n", member.ID, member.Name, member.Email)
+	}
+	for _, member := range result.Unmatched {
+		fmt.Printf("Unmatched member %s: %s\n", member.ID, member.Name)
+	}
+
+	fmt.Printf("Succeeded")
+}
@@ -15,7 +15,6 @@ import (
 	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
 	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
 	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
-	cfginterfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
 )
 
 func main() {
@@ -58,12 +57,9 @@ func main() {
 		os.Exit(1)
 	}
 
-	// custom headers to enable options
-	params := make(map[string][]string, 0)
-	params["sentiment"] = []string{"true"}
-	ctx = cfginterfaces.WithCustomParameters(ctx, params)
-
-	topicsResult, err := asyncClient.GetTopics(ctx, jobConvo.ConversationID)
+	topicsResult, err := asyncClient.GetTopicsWithOptions(ctx, jobConvo.ConversationID, interfaces.TopicsOptions{
+		Sentiment: true,
+	})
 	if err != nil {
 		fmt.Printf("GetTopics failed. Err: %v\n", err)
 		os.Exit(1)
@@ -0,0 +1,68 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	management "github.com/symblai/symbl-go-sdk/pkg/api/management/v1"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	filename := flag.String("f", "trackers.yaml", "tracker definitions in YAML or JSON")
+	dryRun := flag.Bool("dry-run", true, "only print the plan")
+	prune := flag.Bool("prune", false, "delete trackers which are not defined in the file")
+	flag.Parse()
+
+	/*
+		Tracker sync
+	*/
+	file, err := os.Open(*filename)
+	if err != nil {
+		fmt.Printf("Open failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+	defer file.Close()
+
+	desired, err := management.LoadTrackers(file)
+	if err != nil {
+		fmt.Printf("LoadTrackers failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	mgmtClient := management.New(restClient)
+
+	plan, err := mgmtClient.SyncTrackers(ctx, desired, management.SyncOpts{
+		DryRun: *dryRun,
+		Prune:  *prune,
+	})
+	if plan != nil {
+		fmt.Printf("\n%s\n", plan)
+	}
+	if err != nil {
+		fmt.Printf("SyncTrackers failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("Succeeded")
+}
@@ -73,16 +73,10 @@ func main() {
 	fmt.Printf("\n")
 
 	// modify
-	updateRequest := interfaces.UpdateTrackerRequest{
-		TrackerArray: []interfaces.TrackerTupleRequest{
-			interfaces.TrackerTupleRequest{
-				Op:    interfaces.TrackerOperationReplace,
-				Path:  interfaces.TrackerPathDescription,
-				Value: "Updated Description",
-			},
-		},
-	}
-	updateResponse, err := mgmtClient.UpdateTracker(ctx, createResponse.Tracker.ID, updateRequest)
+	patch := management.NewTrackerPatch(&createResponse.Tracker).
+		ReplaceDescription("Updated Description").
+		AddVocabulary("Updated Phrase")
+	updateResponse, err := mgmtClient.PatchTracker(ctx, patch)
 	if err != nil {
 		fmt.Printf("CreateTracker failed. Err: %v\n", err)
 		os.Exit(1)
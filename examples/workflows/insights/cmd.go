@@ -0,0 +1,79 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	prettyjson "github.com/hokaccha/go-prettyjson"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	symbl "github.com/symblai/symbl-go-sdk/pkg/client"
+)
+
+func main() {
+	symbl.Init(symbl.SybmlInit{
+		LogLevel: symbl.LogLevelTrace,
+	})
+
+	/*
+		------------------------------------
+		submit, wait and fetch insights
+		------------------------------------
+	*/
+	ctx := context.Background()
+
+	restClient, err := symbl.NewRestClient(ctx)
+	if err == nil {
+		fmt.Println("Succeeded!")
+	} else {
+		fmt.Printf("New failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	asyncClient := async.New(restClient)
+
+	insights, err := asyncClient.ProcessURL(ctx, interfaces.AsyncURLFileRequest{
+		URL: "https://symbltestdata.s3.us-east-2.amazonaws.com/newPhonecall.mp3",
+	}, interfaces.WorkflowOpts{
+		Insights: []string{
+			interfaces.InsightTypeTopics,
+			interfaces.InsightTypeQuestions,
+			interfaces.InsightTypeActionItems,
+			interfaces.InsightTypeSummary,
+		},
+	})
+	if err != nil {
+		fmt.Printf("ProcessURL failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	for insightType, err := range insights.Errors {
+		fmt.Printf("Fetching %s failed. Err: %v\n", insightType, err)
+	}
+
+	// print it
+	byData, err := json.Marshal(insights)
+	if err != nil {
+		fmt.Printf("ConversationInsights json.Marshal failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	prettyJson, err := prettyjson.Format(byData)
+	if err != nil {
+		fmt.Printf("prettyjson.Marshal failed. Err: %v\n", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("\n\n")
+	fmt.Printf("%s\n", prettyJson)
+	fmt.Printf("\n\n")
+
+	fmt.Printf("Succeeded")
+}
@@ -9,6 +9,7 @@ package async
 
 import (
 	"context"
+	"io"
 	"net/http"
 	"time"
 
@@ -154,6 +155,48 @@ func (c *Client) PostFileWithOptions(ctx context.Context, filePath string, ufReq
 	return &jobConvo, nil
 }
 
+// PostReader posts a conversation read from an io.Reader to the platform with given options. Set size to -1
+// when the size is unknown. mediaType is either audio or video and is detected from the content when empty.
+// Use interfaces.WithUploadProgress on the context to receive upload progress. Cancelling the context aborts the upload.
+func (c *Client) PostReader(ctx context.Context, r io.Reader, size int64, mediaType string, ufRequest asyncinterfaces.AsyncURLFileRequest) (*JobConversation, error) {
+	klog.V(6).Infof("async.PostReader ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if r == nil {
+		klog.V(1).Infof("reader is nil\n")
+		klog.V(6).Infof("async.PostReader LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	klog.V(3).Infof("size: %d, mediaType: %s\n", size, mediaType)
+
+	// send the reader!
+	var jobConvo JobConversation
+
+	err := c.DoReaderWithOptions(ctx, r, size, mediaType, ufRequest, &jobConvo)
+
+	if err != nil {
+		if e, ok := err.(*interfaces.StatusError); ok {
+			if e.Resp.StatusCode != http.StatusOK {
+				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+				klog.V(6).Infof("async.PostReader LEAVE\n")
+				return nil, err
+			}
+		}
+
+		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
+		klog.V(6).Infof("async.PostReader LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("async.PostReader Succeeded\n")
+	klog.V(6).Infof("async.PostReader LEAVE\n")
+	return &jobConvo, nil
+}
+
 // WaitForJobCompleteOnce is a convenience wrapper for checking if the platform is finished processing a conversation
 func (c *Client) WaitForJobCompleteOnce(ctx context.Context, jobId string) (bool, error) {
 	klog.V(6).Infof("async.WaitForJobCompleteOnce ENTER\n")
@@ -0,0 +1,236 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Async package for processing Async conversations
+*/
+package async
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// CreateBookmarkFromTimeRange bookmarks the messages which overlap [start, end)
+func (c *Client) CreateBookmarkFromTimeRange(ctx context.Context, conversationId string, opts asyncinterfaces.BookmarkOpts, start, end time.Duration) (*asyncinterfaces.Bookmark, error) {
+	klog.V(6).Infof("async.CreateBookmarkFromTimeRange ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !validBookmarkOpts(opts) {
+		klog.V(1).Infof("BookmarkOpts requires a Label and a User\n")
+		klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if start < 0 || end <= start {
+		klog.V(1).Infof("Time range %v-%v is invalid\n", start, end)
+		klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	messages, err := c.GetMessages(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+		return nil, err
+	}
+
+	base := ConversationStart(messages.Messages)
+	refs := make([]asyncinterfaces.MessageRefRequest, 0)
+	for _, message := range messages.Messages {
+		messageStart, messageEnd := MessageTiming(message, base)
+		if messageEnd > start && messageStart < end {
+			refs = append(refs, asyncinterfaces.MessageRefRequest{ID: message.ID})
+		}
+	}
+	if len(refs) == 0 {
+		klog.V(1).Infof("No messages in %v-%v\n", start, end)
+		klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+		return nil, ErrNoMessagesFound
+	}
+
+	request := bookmarkRequest(opts, opts.Label, start, end, refs)
+
+	bookmark, err := c.CreateBookmark(ctx, conversationId, request)
+	if err != nil {
+		klog.V(1).Infof("CreateBookmark failed. Err: %v\n", err)
+		klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("CreateBookmarkFromTimeRange succeeded with %d messages\n", len(refs))
+	klog.V(6).Infof("async.CreateBookmarkFromTimeRange LEAVE\n")
+	return bookmark, nil
+}
+
+// CreateBookmarksFromText bookmarks every message containing the text, ignoring case
+func (c *Client) CreateBookmarksFromText(ctx context.Context, conversationId string, opts asyncinterfaces.BookmarkOpts, text string) ([]CreateBookmarkResult, error) {
+	if strings.TrimSpace(text) == "" {
+		klog.V(1).Infof("text is empty\n")
+		return nil, ErrInvalidInput
+	}
+
+	text = strings.ToLower(text)
+	return c.createBookmarksMatching(ctx, conversationId, opts, func(message string) bool {
+		return strings.Contains(strings.ToLower(message), text)
+	})
+}
+
+// CreateBookmarksFromRegexp bookmarks every message matching the regular expression
+func (c *Client) CreateBookmarksFromRegexp(ctx context.Context, conversationId string, opts asyncinterfaces.BookmarkOpts, re *regexp.Regexp) ([]CreateBookmarkResult, error) {
+	if re == nil {
+		klog.V(1).Infof("regexp is nil\n")
+		return nil, ErrInvalidInput
+	}
+	return c.createBookmarksMatching(ctx, conversationId, opts, re.MatchString)
+}
+
+// CreateBookmarks creates the bookmarks using at most concurrency requests at a time. A result
+// is returned for every request in the same order as requests.
+func (c *Client) CreateBookmarks(ctx context.Context, conversationId string, requests []asyncinterfaces.BookmarkRequest, concurrency int) []CreateBookmarkResult {
+	klog.V(6).Infof("async.CreateBookmarks ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make([]CreateBookmarkResult, len(requests))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, request asyncinterfaces.BookmarkRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].Bookmark, results[i].Err = c.CreateBookmark(ctx, conversationId, request)
+		}(i, request)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	klog.V(3).Infof("CreateBookmarks completed. Total: %d, Failed: %d\n", len(results), failed)
+	klog.V(6).Infof("async.CreateBookmarks LEAVE\n")
+	return results
+}
+
+// DeleteBookmarks removes the bookmarks using at most concurrency requests at a time. A result
+// is returned for every bookmark in the same order as bookmarkIds.
+func (c *Client) DeleteBookmarks(ctx context.Context, conversationId string, bookmarkIds []string, concurrency int) []DeleteBookmarkResult {
+	klog.V(6).Infof("async.DeleteBookmarks ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make([]DeleteBookmarkResult, len(bookmarkIds))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, bookmarkId := range bookmarkIds {
+		results[i].BookmarkID = bookmarkId
+
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, bookmarkId string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].Err = c.DeleteBookmark(ctx, conversationId, bookmarkId)
+		}(i, bookmarkId)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	klog.V(3).Infof("DeleteBookmarks completed. Total: %d, Failed: %d\n", len(results), failed)
+	klog.V(6).Infof("async.DeleteBookmarks LEAVE\n")
+	return results
+}
+
+// createBookmarksMatching creates a bookmark for each message whose text matches
+func (c *Client) createBookmarksMatching(ctx context.Context, conversationId string, opts asyncinterfaces.BookmarkOpts, match func(string) bool) ([]CreateBookmarkResult, error) {
+	klog.V(6).Infof("async.createBookmarksMatching ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !validBookmarkOpts(opts) {
+		klog.V(1).Infof("BookmarkOpts requires a Label and a User\n")
+		klog.V(6).Infof("async.createBookmarksMatching LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	messages, err := c.GetMessages(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("async.createBookmarksMatching LEAVE\n")
+		return nil, err
+	}
+
+	base := ConversationStart(messages.Messages)
+	requests := make([]asyncinterfaces.BookmarkRequest, 0)
+	for _, message := range messages.Messages {
+		if !match(message.Text) {
+			continue
+		}
+		start, end := MessageTiming(message, base)
+		requests = append(requests, bookmarkRequest(opts, message.Text, start, end,
+			[]asyncinterfaces.MessageRefRequest{{ID: message.ID}}))
+	}
+	if len(requests) == 0 {
+		klog.V(1).Infof("No messages matched\n")
+		klog.V(6).Infof("async.createBookmarksMatching LEAVE\n")
+		return nil, ErrNoMessagesFound
+	}
+
+	results := c.CreateBookmarks(ctx, conversationId, requests, 0)
+
+	klog.V(3).Infof("createBookmarksMatching matched %d messages\n", len(requests))
+	klog.V(6).Infof("async.createBookmarksMatching LEAVE\n")
+	return results, nil
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	klog "k8s.io/klog/v2"
 
@@ -171,3 +172,81 @@ func (c *Client) GetCallScore(ctx context.Context, conversationId string) (*asyn
 	klog.V(6).Infof("async.GetCallScore LEAVE\n")
 	return &result, nil
 }
+
+// WaitForCallScore polls the call score status until it completes and then returns the call
+// score. Polling stops early when the context is done or the call score or insights fail.
+func (c *Client) WaitForCallScore(ctx context.Context, conversationId string, opts asyncinterfaces.WaitForCallScoreOpts) (*asyncinterfaces.CallScoreResult, error) {
+	klog.V(6).Infof("async.WaitForCallScore ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if opts.TotalWaitInSeconds <= 0 {
+		opts.TotalWaitInSeconds = defaultWaitForCompletion
+	}
+	if opts.WaitInSeconds <= 0 {
+		opts.WaitInSeconds = defaultDelayBetweenCheck
+	}
+
+	deadline := time.NewTimer(time.Duration(opts.TotalWaitInSeconds) * time.Second)
+	defer deadline.Stop()
+
+	for {
+		status, err := c.GetCallScoreStatusById(ctx, conversationId)
+		if err != nil {
+			klog.V(1).Infof("GetCallScoreStatusById failed. Err: %v\n", err)
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, err
+		}
+		klog.V(4).Infof("Call score status: %s\n", status.Status)
+
+		switch status.Status {
+		case JobStatusComplete:
+			result, err := c.GetCallScore(ctx, conversationId)
+			if err != nil {
+				klog.V(1).Infof("GetCallScore failed. Err: %v\n", err)
+				klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+				return nil, err
+			}
+
+			klog.V(3).Infof("WaitForCallScore succeeded\n")
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return result, nil
+		case JobStatusFailed:
+			klog.V(1).Infof("Call score failed\n")
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, ErrCallScoreFailed
+		}
+
+		// the call score never completes when the insights it depends on failed
+		insight, err := c.GetInsightStatusById(ctx, conversationId)
+		if err != nil {
+			klog.V(1).Infof("GetInsightStatusById failed. Err: %v\n", err)
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, err
+		}
+		if insight.Status == JobStatusFailed {
+			klog.V(1).Infof("Insights failed\n")
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, ErrCallScoreFailed
+		}
+
+		select {
+		case <-ctx.Done():
+			klog.V(1).Infof("Context done. Err: %v\n", ctx.Err())
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, ctx.Err()
+		case <-deadline.C:
+			klog.V(1).Infof("call score status timed out\n")
+			klog.V(6).Infof("async.WaitForCallScore LEAVE\n")
+			return nil, ErrJobStatusTimeout
+		case <-time.After(time.Duration(opts.WaitInSeconds) * time.Second):
+		}
+	}
+}
@@ -14,6 +14,21 @@ import (
 const (
 	JobStatusInProgress string = "in_progress"
 	JobStatusComplete   string = "completed"
+	JobStatusFailed     string = "failed"
+)
+
+const (
+	// max size of a job status webhook payload
+	maxWebhookPayloadSize int64 = 64 * 1024
+
+	// max number of finished job statuses kept for a late WaitForJob. oldest are evicted first.
+	maxFinishedJobs int = 1000
+
+	// page size used when walking all conversations
+	defaultConversationsPageSize int = 100
+
+	// number of concurrent requests used by the bulk create and delete helpers
+	defaultBulkConcurrency int = 5
 )
 
 var (
@@ -25,4 +40,19 @@ var (
 
 	// ErrInvalidURIExtension couldn't find a period to indicate a file extension
 	ErrInvalidURIExtension = errors.New("couldn't find a period to indicate a file extension")
+
+	// ErrJobFailed the platform reported the job failed
+	ErrJobFailed = errors.New("the platform reported the job failed")
+
+	// ErrUnknownSpeaker the speaker segment refers to a speaker which is not in the roster
+	ErrUnknownSpeaker = errors.New("speaker not found in the roster")
+
+	// ErrNoMessagesFound no message overlaps the time range or matches the search
+	ErrNoMessagesFound = errors.New("no messages found for the bookmark")
+
+	// ErrCallScoreFailed the platform reported the call score or the insights it depends on failed
+	ErrCallScoreFailed = errors.New("the platform reported the call score failed")
+
+	// ErrUnknownInsightType the insight type is not supported by the workflow helpers
+	ErrUnknownInsightType = errors.New("unknown insight type")
 )
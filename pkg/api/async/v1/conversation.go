@@ -8,9 +8,12 @@
 package async
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 
 	klog "k8s.io/klog/v2"
 
@@ -21,23 +24,33 @@ import (
 
 // GetConversations obtains a list of conversations for the account
 func (c *Client) GetConversations(ctx context.Context) (*asyncinterfaces.ConversationsResult, error) {
-	klog.V(6).Infof("async.GetConversations ENTER\n")
+	return c.GetConversationsWithOptions(ctx, asyncinterfaces.ConversationsListOptions{})
+}
+
+// GetConversationsWithOptions obtains a page of conversations for the account using the list options
+func (c *Client) GetConversationsWithOptions(ctx context.Context, opts asyncinterfaces.ConversationsListOptions) (*asyncinterfaces.ConversationsResult, error) {
+	klog.V(6).Infof("async.GetConversationsWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if opts.Limit < 0 || opts.Offset < 0 {
+		klog.V(1).Infof("Limit or Offset is negative\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
+		return nil, ErrInvalidInput
+	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.ConversationsURI),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, conversationsListParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetConversations LEAVE\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -50,21 +63,85 @@ func (c *Client) GetConversations(ctx context.Context) (*asyncinterfaces.Convers
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetConversations LEAVE\n")
+				klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetConversations LEAVE\n")
+		klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Conversations succeeded\n")
-	klog.V(6).Infof("async.GetConversations LEAVE\n")
+	klog.V(6).Infof("async.GetConversationsWithOptions LEAVE\n")
 	return &result, nil
 }
 
+// ListConversations returns an iterator which transparently walks all pages of conversations
+// starting at opts.Offset. opts.Limit is used as the requested page size and the iteration ends
+// at the first empty page.
+func (c *Client) ListConversations(ctx context.Context, opts asyncinterfaces.ConversationsListOptions) *ConversationIterator {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = defaultConversationsPageSize
+	}
+
+	return &ConversationIterator{
+		client: c,
+		ctx:    ctx,
+		opts:   opts,
+	}
+}
+
+// Next advances to the next conversation fetching the next page when needed. It returns false
+// when there are no more conversations or an error occurred.
+func (ci *ConversationIterator) Next() bool {
+	if ci.err != nil {
+		return false
+	}
+
+	if ci.index >= len(ci.page) {
+		if ci.done {
+			return false
+		}
+
+		result, err := ci.client.GetConversationsWithOptions(ci.ctx, ci.opts)
+		if err != nil {
+			klog.V(1).Infof("GetConversationsWithOptions failed. Err: %v\n", err)
+			ci.err = err
+			return false
+		}
+
+		ci.page = result.Conversations
+		ci.index = 0
+		ci.opts.Offset += len(ci.page)
+
+		// the platform may return less than the requested page size so only an empty page
+		// marks the end
+		if len(ci.page) == 0 {
+			ci.done = true
+			return false
+		}
+	}
+
+	ci.current = &ci.page[ci.index]
+	ci.index++
+	return true
+}
+
+// Conversation returns the current conversation
+func (ci *ConversationIterator) Conversation() *asyncinterfaces.Conversation {
+	return ci.current
+}
+
+// Err returns the error which stopped the iteration, if any
+func (ci *ConversationIterator) Err() error {
+	return ci.err
+}
+
 // GetConversation obtains conversation details by conversation ID
 func (c *Client) GetConversation(ctx context.Context, conversationId string) (*asyncinterfaces.Conversation, error) {
 	klog.V(6).Infof("async.GetConversation ENTER\n")
@@ -115,3 +192,164 @@ func (c *Client) GetConversation(ctx context.Context, conversationId string) (*a
 	klog.V(6).Infof("async.GetConversations LEAVE\n")
 	return &result, nil
 }
+
+// UpdateConversation updates the name and/or metadata of a conversation
+func (c *Client) UpdateConversation(ctx context.Context, conversationId string, request asyncinterfaces.UpdateConversationRequest) (*asyncinterfaces.Conversation, error) {
+	klog.V(6).Infof("async.UpdateConversation ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if request.Name == "" && len(request.Metadata) == 0 {
+		klog.V(1).Infof("Name and Metadata are empty\n")
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	// request
+	URI := fmt.Sprintf("%s%s",
+		version.GetAsyncAPI(version.ConversationURI, conversationId),
+		c.getQueryParamFromContext(ctx))
+	klog.V(6).Infof("Calling %s\n", URI)
+
+	jsonStr, err := json.Marshal(request)
+	if err != nil {
+		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PUT", URI, bytes.NewBuffer(jsonStr))
+	if err != nil {
+		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	// check the status
+	var result asyncinterfaces.Conversation
+
+	err = c.Client.Do(ctx, req, &result)
+
+	if err != nil {
+		if e, ok := err.(*interfaces.StatusError); ok {
+			if e.Resp.StatusCode != http.StatusOK {
+				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+				klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+				return nil, err
+			}
+		}
+
+		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
+		klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("PUT Conversation succeeded\n")
+	klog.V(6).Infof("async.UpdateConversation LEAVE\n")
+	return &result, nil
+}
+
+// DeleteConversation removes a conversation and all of its data
+func (c *Client) DeleteConversation(ctx context.Context, conversationId string) error {
+	klog.V(6).Infof("async.DeleteConversation ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	// request
+	URI := fmt.Sprintf("%s%s",
+		version.GetAsyncAPI(version.ConversationURI, conversationId),
+		c.getQueryParamFromContext(ctx))
+	klog.V(6).Infof("Calling %s\n", URI)
+
+	req, err := http.NewRequestWithContext(ctx, "DELETE", URI, nil)
+	if err != nil {
+		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return err
+	}
+
+	// check the status
+	err = c.Client.Do(ctx, req, nil)
+
+	if err != nil {
+		if e, ok := err.(*interfaces.StatusError); ok {
+			if e.Resp.StatusCode != http.StatusOK {
+				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
+				klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+				return err
+			}
+		}
+
+		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
+		klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+		return err
+	}
+
+	klog.V(3).Infof("DELETE Conversation succeeded\n")
+	klog.V(6).Infof("async.DeleteConversation LEAVE\n")
+	return nil
+}
+
+// DeleteConversations removes the conversations using at most concurrency requests at a time.
+// A result is returned for every conversation in the same order as conversationIds.
+func (c *Client) DeleteConversations(ctx context.Context, conversationIds []string, concurrency int) []DeleteConversationResult {
+	klog.V(6).Infof("async.DeleteConversations ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if concurrency <= 0 {
+		concurrency = defaultBulkConcurrency
+	}
+
+	results := make([]DeleteConversationResult, len(conversationIds))
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, conversationId := range conversationIds {
+		results[i].ConversationID = conversationId
+
+		select {
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, conversationId string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i].Err = c.DeleteConversation(ctx, conversationId)
+		}(i, conversationId)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+	}
+
+	klog.V(3).Infof("DeleteConversations completed. Total: %d, Failed: %d\n", len(results), failed)
+	klog.V(6).Infof("async.DeleteConversations LEAVE\n")
+	return results
+}
@@ -24,7 +24,13 @@ import (
 
 // GetTopics obtains topics in a conversation
 func (c *Client) GetTopics(ctx context.Context, conversationId string) (*asyncinterfaces.TopicResult, error) {
-	klog.V(6).Infof("async.GetTopics ENTER\n")
+	return c.GetTopicsWithOptions(ctx, conversationId, asyncinterfaces.TopicsOptions{})
+}
+
+// GetTopicsWithOptions obtains topics in a conversation using typed options, falling back to
+// parameters in the context
+func (c *Client) GetTopicsWithOptions(ctx context.Context, conversationId string, opts asyncinterfaces.TopicsOptions) (*asyncinterfaces.TopicResult, error) {
+	klog.V(6).Infof("async.GetTopicsWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -32,20 +38,20 @@ func (c *Client) GetTopics(ctx context.Context, conversationId string) (*asyncin
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetTopics LEAVE\n")
+		klog.V(6).Infof("async.GetTopicsWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.TopicsURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, topicsParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetTopics LEAVE\n")
+		klog.V(6).Infof("async.GetTopicsWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -58,24 +64,30 @@ func (c *Client) GetTopics(ctx context.Context, conversationId string) (*asyncin
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetTopics LEAVE\n")
+				klog.V(6).Infof("async.GetTopicsWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetTopics LEAVE\n")
+		klog.V(6).Infof("async.GetTopicsWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Topics succeeded\n")
-	klog.V(6).Infof("async.GetTopics LEAVE\n")
+	klog.V(6).Infof("async.GetTopicsWithOptions LEAVE\n")
 	return &result, nil
 }
 
 // GetQuestions obtains questions in a conversation
 func (c *Client) GetQuestions(ctx context.Context, conversationId string) (*asyncinterfaces.QuestionResult, error) {
-	klog.V(6).Infof("async.GetQuestions ENTER\n")
+	return c.GetQuestionsWithOptions(ctx, conversationId, asyncinterfaces.QuestionsOptions{})
+}
+
+// GetQuestionsWithOptions obtains questions in a conversation using typed options, falling back to
+// parameters in the context
+func (c *Client) GetQuestionsWithOptions(ctx context.Context, conversationId string, opts asyncinterfaces.QuestionsOptions) (*asyncinterfaces.QuestionResult, error) {
+	klog.V(6).Infof("async.GetQuestionsWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -83,20 +95,20 @@ func (c *Client) GetQuestions(ctx context.Context, conversationId string) (*asyn
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetQuestions LEAVE\n")
+		klog.V(6).Infof("async.GetQuestionsWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.QuestionsURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, questionsParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetQuestions LEAVE\n")
+		klog.V(6).Infof("async.GetQuestionsWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -109,18 +121,18 @@ func (c *Client) GetQuestions(ctx context.Context, conversationId string) (*asyn
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetQuestions LEAVE\n")
+				klog.V(6).Infof("async.GetQuestionsWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetQuestions LEAVE\n")
+		klog.V(6).Infof("async.GetQuestionsWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Questions succeeded\n")
-	klog.V(6).Infof("async.GetQuestions LEAVE\n")
+	klog.V(6).Infof("async.GetQuestionsWithOptions LEAVE\n")
 	return &result, nil
 }
 
@@ -177,7 +189,13 @@ func (c *Client) GetFollowUps(ctx context.Context, conversationId string) (*asyn
 
 // GetEntities obtains entities in a conversation
 func (c *Client) GetEntities(ctx context.Context, conversationId string) (*asyncinterfaces.EntityResult, error) {
-	klog.V(6).Infof("async.GetEntities ENTER\n")
+	return c.GetEntitiesWithOptions(ctx, conversationId, asyncinterfaces.EntitiesOptions{})
+}
+
+// GetEntitiesWithOptions obtains entities in a conversation using typed options, falling back to
+// parameters in the context
+func (c *Client) GetEntitiesWithOptions(ctx context.Context, conversationId string, opts asyncinterfaces.EntitiesOptions) (*asyncinterfaces.EntityResult, error) {
+	klog.V(6).Infof("async.GetEntitiesWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -185,20 +203,20 @@ func (c *Client) GetEntities(ctx context.Context, conversationId string) (*async
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetEntities LEAVE\n")
+		klog.V(6).Infof("async.GetEntitiesWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.EntitiesURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, entitiesParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetEntities LEAVE\n")
+		klog.V(6).Infof("async.GetEntitiesWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -211,18 +229,18 @@ func (c *Client) GetEntities(ctx context.Context, conversationId string) (*async
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetEntities LEAVE\n")
+				klog.V(6).Infof("async.GetEntitiesWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetEntities LEAVE\n")
+		klog.V(6).Infof("async.GetEntitiesWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Entities succeeded\n")
-	klog.V(6).Infof("async.GetEntities LEAVE\n")
+	klog.V(6).Infof("async.GetEntitiesWithOptions LEAVE\n")
 	return &result, nil
 }
 
@@ -279,7 +297,13 @@ func (c *Client) GetActionItems(ctx context.Context, conversationId string) (*as
 
 // GetMessages obtains messages in a conversation
 func (c *Client) GetMessages(ctx context.Context, conversationId string) (*asyncinterfaces.MessageResult, error) {
-	klog.V(6).Infof("async.GetMessages ENTER\n")
+	return c.GetMessagesWithOptions(ctx, conversationId, asyncinterfaces.MessagesOptions{})
+}
+
+// GetMessagesWithOptions obtains messages in a conversation using typed options, falling back to
+// parameters in the context
+func (c *Client) GetMessagesWithOptions(ctx context.Context, conversationId string, opts asyncinterfaces.MessagesOptions) (*asyncinterfaces.MessageResult, error) {
+	klog.V(6).Infof("async.GetMessagesWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -287,20 +311,20 @@ func (c *Client) GetMessages(ctx context.Context, conversationId string) (*async
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetMessages LEAVE\n")
+		klog.V(6).Infof("async.GetMessagesWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.MessagesURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, messagesParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetMessages LEAVE\n")
+		klog.V(6).Infof("async.GetMessagesWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -313,24 +337,30 @@ func (c *Client) GetMessages(ctx context.Context, conversationId string) (*async
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetMessages LEAVE\n")
+				klog.V(6).Infof("async.GetMessagesWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetMessages LEAVE\n")
+		klog.V(6).Infof("async.GetMessagesWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Messages succeeded\n")
-	klog.V(6).Infof("async.GetMessages LEAVE\n")
+	klog.V(6).Infof("async.GetMessagesWithOptions LEAVE\n")
 	return &result, nil
 }
 
 // GetSummary obtains a summary for a conversation
 func (c *Client) GetSummary(ctx context.Context, conversationId string) (*asyncinterfaces.SummaryResult, error) {
-	klog.V(6).Infof("async.GetSummary ENTER\n")
+	return c.GetSummaryWithOptions(ctx, conversationId, asyncinterfaces.SummaryOptions{})
+}
+
+// GetSummaryWithOptions obtains a summary for a conversation using typed options, falling back to
+// parameters in the context
+func (c *Client) GetSummaryWithOptions(ctx context.Context, conversationId string, opts asyncinterfaces.SummaryOptions) (*asyncinterfaces.SummaryResult, error) {
+	klog.V(6).Infof("async.GetSummaryWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -338,20 +368,20 @@ func (c *Client) GetSummary(ctx context.Context, conversationId string) (*asynci
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetSummary LEAVE\n")
+		klog.V(6).Infof("async.GetSummaryWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.SummaryURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, summaryParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", URI, nil)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetSummary LEAVE\n")
+		klog.V(6).Infof("async.GetSummaryWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -364,18 +394,18 @@ func (c *Client) GetSummary(ctx context.Context, conversationId string) (*asynci
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetSummary LEAVE\n")
+				klog.V(6).Infof("async.GetSummaryWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetSummary LEAVE\n")
+		klog.V(6).Infof("async.GetSummaryWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Summary succeeded\n")
-	klog.V(6).Infof("async.GetSummary LEAVE\n")
+	klog.V(6).Infof("async.GetSummaryWithOptions LEAVE\n")
 	return &result, nil
 }
 
@@ -483,7 +513,13 @@ func (c *Client) GetTracker(ctx context.Context, conversationId string) (*asynci
 
 // GetTranscript obtains transcript for a conversation
 func (c *Client) GetTranscript(ctx context.Context, conversationId string, request asyncinterfaces.TranscriptRequest) (*asyncinterfaces.TranscriptResult, error) {
-	klog.V(6).Infof("async.GetTranscript ENTER\n")
+	return c.GetTranscriptWithOptions(ctx, conversationId, request, asyncinterfaces.TranscriptOptions{})
+}
+
+// GetTranscriptWithOptions obtains a transcript using typed options, falling back to parameters in
+// the context
+func (c *Client) GetTranscriptWithOptions(ctx context.Context, conversationId string, request asyncinterfaces.TranscriptRequest, opts asyncinterfaces.TranscriptOptions) (*asyncinterfaces.TranscriptResult, error) {
+	klog.V(6).Infof("async.GetTranscriptWithOptions ENTER\n")
 
 	// checks
 	if ctx == nil {
@@ -491,7 +527,7 @@ func (c *Client) GetTranscript(ctx context.Context, conversationId string, reque
 	}
 	if conversationId == "" {
 		klog.V(1).Infof("conversationId is empty\n")
-		klog.V(6).Infof("async.GetTranscript LEAVE\n")
+		klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 		return nil, ErrInvalidInput
 	}
 
@@ -511,27 +547,27 @@ func (c *Client) GetTranscript(ctx context.Context, conversationId string, reque
 		for _, e := range err.(validator.ValidationErrors) {
 			klog.V(1).Infof("GetTranscript validation failed. Err: %v\n", e)
 		}
-		klog.V(6).Infof("mgmt.GetTranscript LEAVE\n")
+		klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	// request
 	URI := fmt.Sprintf("%s%s",
 		version.GetAsyncAPI(version.TranscriptURI, conversationId),
-		c.getQueryParamFromContext(ctx))
+		c.getQueryParamFromOptions(ctx, transcriptParams(opts)))
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	jsonStr, err := json.Marshal(request)
 	if err != nil {
 		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
-		klog.V(6).Infof("mgmt.GetTranscript LEAVE\n")
+		klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", URI, bytes.NewBuffer(jsonStr))
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("async.GetTranscript LEAVE\n")
+		klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 		return nil, err
 	}
 
@@ -544,17 +580,17 @@ func (c *Client) GetTranscript(ctx context.Context, conversationId string, reque
 		if e, ok := err.(*interfaces.StatusError); ok {
 			if e.Resp.StatusCode != http.StatusOK {
 				klog.V(1).Infof("HTTP Code: %v\n", e.Resp.StatusCode)
-				klog.V(6).Infof("async.GetTranscript LEAVE\n")
+				klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 				return nil, err
 			}
 		}
 
 		klog.V(1).Infof("Platform Supplied Err: %v\n", err)
-		klog.V(6).Infof("async.GetTranscript LEAVE\n")
+		klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 		return nil, err
 	}
 
 	klog.V(3).Infof("GET Transcript succeeded\n")
-	klog.V(6).Infof("async.GetTranscript LEAVE\n")
+	klog.V(6).Infof("async.GetTranscriptWithOptions LEAVE\n")
 	return &result, nil
 }
@@ -15,4 +15,23 @@ const (
 	// speaker update
 	SpeakerEventTypeStart   string = "started_speaking"
 	SpeakerEventTypeStopped string = "stopped_speaking"
+
+	// conversation list ordering
+	ConversationsOrderAsc  string = "asc"
+	ConversationsOrderDesc string = "desc"
+
+	// conversation list sorting
+	ConversationsSortStartTime string = "conversation.startTime"
+
+	// insight types fetched by the workflow helpers
+	InsightTypeMessages    string = "messages"
+	InsightTypeTopics      string = "topics"
+	InsightTypeQuestions   string = "questions"
+	InsightTypeActionItems string = "action-items"
+	InsightTypeFollowUps   string = "follow-ups"
+	InsightTypeSummary     string = "summary"
+	InsightTypeEntities    string = "entities"
+	InsightTypeAnalytics   string = "analytics"
+	InsightTypeTrackers    string = "trackers"
+	InsightTypeMembers     string = "members"
 )
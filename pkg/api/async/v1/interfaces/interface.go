@@ -43,3 +43,9 @@ type InsightCallback interface {
 	// BookmarksSummaryResult(er *BookmarksSummaryResult) error
 	// SummaryUIResult(er *SummaryUIResult) error
 }
+
+// JobStatusCallback is notified when a job status webhook is received from the platform
+type JobStatusCallback interface {
+	// JobStatusMessage is called for every valid job status webhook
+	JobStatusMessage(js *JobStatusEvent) error
+}
@@ -7,6 +7,10 @@ Defines everything that makes up the Async API interface
 */
 package interfaces
 
+import (
+	"time"
+)
+
 /*
 Shared definitions
 */
@@ -207,16 +211,18 @@ type Member struct {
 }
 
 type Conversation struct {
-	ID        string   `json:"id,omitempty"`
-	Type      string   `json:"type,omitempty"`
-	Name      string   `json:"name,omitempty"`
-	StartTime string   `json:"startTime,omitempty"`
-	EndTime   string   `json:"endTime,omitempty"`
-	Members   []Member `json:"members,omitempty"`
-	Metadata  struct {
-	} `json:"metadata,omitempty"` // TODO: need to revisit this
+	ID        string               `json:"id,omitempty"`
+	Type      string               `json:"type,omitempty"`
+	Name      string               `json:"name,omitempty"`
+	StartTime string               `json:"startTime,omitempty"`
+	EndTime   string               `json:"endTime,omitempty"`
+	Members   []Member             `json:"members,omitempty"`
+	Metadata  ConversationMetadata `json:"metadata,omitempty"`
 }
 
+// ConversationMetadata is user defined data attached to a conversation
+type ConversationMetadata map[string]interface{}
+
 type SpeakerEvent struct {
 	Type   string `json:"type,omitempty"`
 	User   Member `json:"user,omitempty"`
@@ -314,6 +320,145 @@ type WaitForJobStatusOpts struct {
 	WaitInSeconds      int64
 }
 
+// WaitForCallScoreOpts parameters for WaitForCallScore
+type WaitForCallScoreOpts struct {
+	TotalWaitInSeconds int64
+	WaitInSeconds      int64
+}
+
+// UpdateConversationRequest for UpdateConversation. Fields left empty are not changed.
+type UpdateConversationRequest struct {
+	Name     string               `json:"name,omitempty"`
+	Metadata ConversationMetadata `json:"metadata,omitempty"`
+}
+
+// RedactionOptions asks the platform to redact PII from messages and transcripts
+type RedactionOptions struct {
+	// Redact enables redaction
+	Redact bool
+	// Include limits redaction to these entity types
+	Include []string
+	// Exclude skips redaction for these entity types
+	Exclude []string
+	// ReplacementToken replaces redacted content. The platform default is used when empty
+	ReplacementToken string
+}
+
+// MessagesOptions query options for GetMessages
+type MessagesOptions struct {
+	// Verbose includes word level timestamps
+	Verbose bool
+	// Sentiment includes sentiment analysis for each message
+	Sentiment bool
+	// Redaction of PII in the messages
+	Redaction RedactionOptions
+}
+
+// TranscriptOptions query options for GetTranscript
+type TranscriptOptions struct {
+	// Redaction of PII in the transcript
+	Redaction RedactionOptions
+}
+
+// TopicsOptions query options for GetTopics
+type TopicsOptions struct {
+	// Sentiment includes sentiment analysis for each topic
+	Sentiment bool
+	// ParentRefs includes the topic hierarchy
+	ParentRefs bool
+}
+
+// QuestionsOptions query options for GetQuestions
+type QuestionsOptions struct {
+	// Sentiment includes sentiment analysis for each question
+	Sentiment bool
+}
+
+// EntitiesOptions query options for GetEntities
+type EntitiesOptions struct {
+	// Type limits the entities to this type, ie person
+	Type string
+	// SubType limits the entities to this sub type
+	SubType string
+}
+
+// SummaryOptions query options for GetSummary
+type SummaryOptions struct {
+	// Refresh regenerates the summary
+	Refresh bool
+}
+
+// ConversationsListOptions filters and pages the list of conversations
+type ConversationsListOptions struct {
+	// Limit is the page size
+	Limit int
+	// Offset is the number of conversations to skip
+	Offset int
+	// Order is one of the ConversationsOrder constants
+	Order string
+	// Sort is one of the ConversationsSort constants
+	Sort string
+	// StartTime and EndTime restrict the list to conversations which started in the range
+	StartTime time.Time
+	EndTime   time.Time
+	// ConversationGroupID restricts the list to conversations in the group
+	ConversationGroupID string
+	// Name restricts the list to conversations matching the name
+	Name string
+}
+
+// JobStatusEvent is the payload the platform posts to the WebhookURL when a job changes status
+type JobStatusEvent struct {
+	JobID          string `json:"jobId" validate:"required"`
+	ConversationID string `json:"conversationId,omitempty"`
+	Status         string `json:"status" validate:"required"`
+}
+
+// WorkflowOpts parameters for the submit, wait and fetch workflow helpers
+type WorkflowOpts struct {
+	// Insights to fetch using the InsightType constants. Defaults to messages, topics, questions,
+	// action items, follow ups and summary
+	Insights           []string
+	TotalWaitInSeconds int64
+	WaitInSeconds      int64
+}
+
+// RosterEntry is a known participant of a conversation
+type RosterEntry struct {
+	// Speaker is the label the recording system uses for the participant in SpeakerSegments
+	Speaker string
+	// Channel is the audio channel of the participant in multi-channel recordings, starting at 1
+	Channel int
+	Name    string
+	Email   string
+	UserID  string
+}
+
+// SpeakerSegment is a period in which a speaker from the roster was talking
+type SpeakerSegment struct {
+	Speaker string
+	Start   time.Duration
+	End     time.Duration
+}
+
+// AssignSpeakersRequest parameters for AssignSpeakers
+type AssignSpeakersRequest struct {
+	Roster []RosterEntry
+	// Segments generate the speaker events. Without segments only the members are reconciled.
+	Segments []SpeakerSegment
+	// MemberMapping maps a member name or ID in the conversation to a roster Speaker. Members
+	// not in the mapping are matched to the roster by email, name or Speaker label.
+	MemberMapping map[string]string
+}
+
+// BookmarkOpts parameters shared by the bookmark helpers. Label and every User field are required.
+type BookmarkOpts struct {
+	Label string
+	// Description defaults to the label for time ranges and to the message text for searches
+	Description string
+	User        User
+}
+
 type MessageRefRequest struct {
 	ID string `json:"id,omitempty"`
 }
@@ -0,0 +1,218 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Async package for processing Async conversations
+*/
+package async
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// SpeakerEventsFromSegments generates started_speaking and stopped_speaking events for the
+// segments, ordered by offset. Stopped events sort before started events at the same offset.
+func SpeakerEventsFromSegments(roster []asyncinterfaces.RosterEntry, segments []asyncinterfaces.SpeakerSegment) ([]asyncinterfaces.SpeakerEvent, error) {
+	speakers := make(map[string]asyncinterfaces.RosterEntry, len(roster))
+	for _, entry := range roster {
+		speakers[entry.Speaker] = entry
+	}
+
+	events := make([]asyncinterfaces.SpeakerEvent, 0, 2*len(segments))
+	for _, segment := range segments {
+		entry, ok := speakers[segment.Speaker]
+		if !ok {
+			klog.V(1).Infof("Speaker %s is not in the roster\n", segment.Speaker)
+			return nil, ErrUnknownSpeaker
+		}
+		if segment.Start < 0 || segment.End <= segment.Start {
+			klog.V(1).Infof("Segment %v-%v for %s is invalid\n", segment.Start, segment.End, segment.Speaker)
+			return nil, ErrInvalidInput
+		}
+
+		user := rosterMember(entry)
+		events = append(events,
+			asyncinterfaces.SpeakerEvent{
+				Type:   asyncinterfaces.SpeakerEventTypeStart,
+				User:   user,
+				Offset: durationToOffset(segment.Start),
+			},
+			asyncinterfaces.SpeakerEvent{
+				Type:   asyncinterfaces.SpeakerEventTypeStopped,
+				User:   user,
+				Offset: durationToOffset(segment.End),
+			})
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		left := offsetToDuration(events[i].Offset)
+		right := offsetToDuration(events[j].Offset)
+		if left != right {
+			return left < right
+		}
+		return events[i].Type == asyncinterfaces.SpeakerEventTypeStopped && events[j].Type != asyncinterfaces.SpeakerEventTypeStopped
+	})
+	return events, nil
+}
+
+// ChannelMetadataFromRoster generates the channel metadata for roster entries with a Channel,
+// for use with multi-channel recordings when submitting a file or URL
+func ChannelMetadataFromRoster(roster []asyncinterfaces.RosterEntry) []asyncinterfaces.ChannelMetadata {
+	metadata := make([]asyncinterfaces.ChannelMetadata, 0)
+	for _, entry := range roster {
+		if entry.Channel <= 0 {
+			continue
+		}
+		metadata = append(metadata, asyncinterfaces.ChannelMetadata{
+			Channel: entry.Channel,
+			Speaker: asyncinterfaces.Speaker{
+				Name:  entry.Name,
+				Email: entry.Email,
+			},
+		})
+	}
+	return metadata
+}
+
+// AssignSpeakers applies the speaker events generated from the segments and then updates the
+// members of the conversation to carry the names and emails from the roster
+func (c *Client) AssignSpeakers(ctx context.Context, conversationId string, request asyncinterfaces.AssignSpeakersRequest) (*AssignSpeakersResult, error) {
+	klog.V(6).Infof("async.AssignSpeakers ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if len(request.Roster) == 0 {
+		klog.V(1).Infof("Roster is empty\n")
+		klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	result := &AssignSpeakersResult{}
+
+	// speaker events
+	if len(request.Segments) > 0 {
+		events, err := SpeakerEventsFromSegments(request.Roster, request.Segments)
+		if err != nil {
+			klog.V(1).Infof("SpeakerEventsFromSegments failed. Err: %v\n", err)
+			klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+			return nil, err
+		}
+
+		err = c.UpdateSpeakers(ctx, conversationId, asyncinterfaces.UpdateSpeakerRequest{
+			SpeakerEvents: events,
+		})
+		if err != nil {
+			klog.V(1).Infof("UpdateSpeakers failed. Err: %v\n", err)
+			klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+			return nil, err
+		}
+		result.Events = len(events)
+	}
+
+	// reconcile members
+	members, err := c.GetMembers(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMembers failed. Err: %v\n", err)
+		klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+		return result, err
+	}
+
+	for _, member := range members.Members {
+		entry, ok := matchRoster(member, request.Roster, request.MemberMapping)
+		if !ok {
+			klog.V(4).Infof("Member %s is not in the roster\n", member.Name)
+			result.Unmatched = append(result.Unmatched, member)
+			continue
+		}
+
+		update := asyncinterfaces.Member{
+			ID:    member.ID,
+			Name:  entry.Name,
+			Email: entry.Email,
+		}
+		if update.Name == "" {
+			update.Name = member.Name
+		}
+		if update.Email == "" {
+			update.Email = member.Email
+		}
+		if update.Name == member.Name && update.Email == member.Email {
+			continue
+		}
+
+		err := c.UpdateMember(ctx, conversationId, update)
+		if err != nil {
+			klog.V(1).Infof("UpdateMember %s failed. Err: %v\n", member.Name, err)
+			result.Errors = append(result.Errors, fmt.Errorf("member %s: %w", member.Name, err))
+			continue
+		}
+		result.Updated = append(result.Updated, update)
+	}
+
+	klog.V(3).Infof("AssignSpeakers sent %d events, updated %d members, %d unmatched\n",
+		result.Events, len(result.Updated), len(result.Unmatched))
+	klog.V(6).Infof("async.AssignSpeakers LEAVE\n")
+	return result, nil
+}
+
+// matchRoster finds the roster entry for a member using the mapping, then email, name and
+// Speaker label
+func matchRoster(member asyncinterfaces.Member, roster []asyncinterfaces.RosterEntry, mapping map[string]string) (asyncinterfaces.RosterEntry, bool) {
+	speaker, mapped := mapping[member.ID]
+	if !mapped {
+		speaker, mapped = mapping[member.Name]
+	}
+
+	for _, entry := range roster {
+		switch {
+		case mapped:
+			if entry.Speaker == speaker {
+				return entry, true
+			}
+		case member.Email != "" && strings.EqualFold(entry.Email, member.Email),
+			member.Name != "" && strings.EqualFold(entry.Name, member.Name),
+			member.Name != "" && entry.Speaker == member.Name:
+			return entry, true
+		}
+	}
+	return asyncinterfaces.RosterEntry{}, false
+}
+
+func rosterMember(entry asyncinterfaces.RosterEntry) asyncinterfaces.Member {
+	id := entry.UserID
+	if id == "" {
+		id = entry.Email
+	}
+	return asyncinterfaces.Member{
+		ID:    id,
+		Name:  entry.Name,
+		Email: entry.Email,
+	}
+}
+
+func durationToOffset(d time.Duration) asyncinterfaces.Offset {
+	return asyncinterfaces.Offset{
+		Seconds: int(d / time.Second),
+		Nanos:   int(d % time.Second),
+	}
+}
+
+func offsetToDuration(o asyncinterfaces.Offset) time.Duration {
+	return time.Duration(o.Seconds)*time.Second + time.Duration(o.Nanos)
+}
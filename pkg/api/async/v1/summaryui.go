@@ -269,7 +269,7 @@ func (c *Client) GetInsightsListUiURI(ctx context.Context) (*asyncinterfaces.Ins
 	}
 
 	// request
-	URI := fmt.Sprintf("%s%s",
+	URI := appendQueryString(
 		version.GetAsyncAPI(version.InsightsListUiURI),
 		c.getQueryParamFromContext(ctx))
 	klog.V(6).Infof("Calling %s\n", URI)
@@ -320,7 +320,7 @@ func (c *Client) GetInsightsDetailsUiURI(ctx context.Context, conversationId str
 	}
 
 	// request
-	URI := fmt.Sprintf("%s%s",
+	URI := appendQueryString(
 		version.GetAsyncAPI(version.InsightsDetailsUiURI, conversationId),
 		c.getQueryParamFromContext(ctx))
 	klog.V(6).Infof("Calling %s\n", URI)
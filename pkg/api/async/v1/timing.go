@@ -0,0 +1,47 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Async package for processing Async conversations
+*/
+package async
+
+import (
+	"time"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// ConversationStart is the earliest message start time which message and word timestamps are
+// relative to. It is the zero time when no message has a valid start time.
+func ConversationStart(messages []asyncinterfaces.Message) time.Time {
+	var start time.Time
+	for _, message := range messages {
+		t, err := time.Parse(time.RFC3339Nano, message.StartTime)
+		if err != nil {
+			continue
+		}
+		if start.IsZero() || t.Before(start) {
+			start = t
+		}
+	}
+	return start
+}
+
+// MessageTiming returns the message start and end relative to the conversation start, using the
+// verbose offsets when present and the start and end times otherwise. Both are zero when the
+// message has no usable timing.
+func MessageTiming(message asyncinterfaces.Message, base time.Time) (time.Duration, time.Duration) {
+	if message.Duration > 0 {
+		start := time.Duration(message.TimeOffset * float64(time.Second))
+		return start, start + time.Duration(message.Duration*float64(time.Second))
+	}
+
+	start, errStart := time.Parse(time.RFC3339Nano, message.StartTime)
+	end, errEnd := time.Parse(time.RFC3339Nano, message.EndTime)
+	if errStart != nil || errEnd != nil || base.IsZero() {
+		return 0, 0
+	}
+	return start.Sub(base), end.Sub(base)
+}
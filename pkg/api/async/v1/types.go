@@ -7,6 +7,14 @@
 */
 package async
 
+import (
+	"context"
+	"sync"
+	"time"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
 /*
 	Output structs for API calls
 */
@@ -21,3 +29,85 @@ type JobConversation struct {
 	JobID          string `json:"jobId"`
 	ConversationID string `json:"conversationId"`
 }
+
+// ConversationInsights aggregates the insights fetched by the workflow helpers. Insights which
+// were not requested or failed to be fetched are nil and failures are reported in Errors.
+type ConversationInsights struct {
+	JobID          string `json:"jobId,omitempty"`
+	ConversationID string `json:"conversationId,omitempty"`
+
+	Messages    *asyncinterfaces.MessageResult    `json:"messages,omitempty"`
+	Topics      *asyncinterfaces.TopicResult      `json:"topics,omitempty"`
+	Questions   *asyncinterfaces.QuestionResult   `json:"questions,omitempty"`
+	ActionItems *asyncinterfaces.ActionItemResult `json:"actionItems,omitempty"`
+	FollowUps   *asyncinterfaces.FollowUpResult   `json:"followUps,omitempty"`
+	Summary     *asyncinterfaces.SummaryResult    `json:"summary,omitempty"`
+	Entities    *asyncinterfaces.EntityResult     `json:"entities,omitempty"`
+	Analytics   *asyncinterfaces.AnalyticsResult  `json:"analytics,omitempty"`
+	Trackers    *asyncinterfaces.TrackerResult    `json:"trackers,omitempty"`
+	Members     *asyncinterfaces.MembersResult    `json:"members,omitempty"`
+
+	// Errors is keyed by InsightType
+	Errors map[string]error `json:"-"`
+}
+
+// WebhookHandler is an http.Handler which receives job status webhooks from the platform
+type WebhookHandler struct {
+	callback asyncinterfaces.JobStatusCallback
+
+	mu       sync.Mutex
+	waiters  map[string][]chan *asyncinterfaces.JobStatusEvent
+	finished map[string]finishedJob
+}
+
+// finishedJob is a completed or failed job status nobody was waiting on yet
+type finishedJob struct {
+	event    *asyncinterfaces.JobStatusEvent
+	received time.Time
+}
+
+// ConversationIterator walks all pages of the conversation list
+type ConversationIterator struct {
+	client *Client
+	ctx    context.Context
+	opts   asyncinterfaces.ConversationsListOptions
+
+	page    []asyncinterfaces.Conversation
+	index   int
+	current *asyncinterfaces.Conversation
+	done    bool
+	err     error
+}
+
+// DeleteConversationResult is the outcome of deleting a single conversation in DeleteConversations
+type DeleteConversationResult struct {
+	ConversationID string
+	Err            error
+}
+
+// CreateBookmarkResult is the outcome of creating a single bookmark in CreateBookmarks
+type CreateBookmarkResult struct {
+	Bookmark *asyncinterfaces.Bookmark
+	Err      error
+}
+
+// DeleteBookmarkResult is the outcome of deleting a single bookmark in DeleteBookmarks
+type DeleteBookmarkResult struct {
+	BookmarkID string
+	Err        error
+}
+
+// AssignSpeakersResult reports the outcome of AssignSpeakers
+type AssignSpeakersResult struct {
+	// Events is the number of speaker events sent
+	Events int
+
+	// Updated are the members which were renamed to their roster identity
+	Updated []asyncinterfaces.Member
+
+	// Unmatched are the members which could not be matched to the roster
+	Unmatched []asyncinterfaces.Member
+
+	// Errors are failures updating individual members
+	Errors []error
+}
@@ -9,15 +9,24 @@ package async
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
 
 	klog "k8s.io/klog/v2"
 
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
 	interfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
+	rest "github.com/symblai/symbl-go-sdk/pkg/client/rest"
 )
 
 func (c *Client) getQueryParamFromContext(ctx context.Context) string {
-	// additional query parameters to URL
+	return c.getQueryParamFromOptions(ctx, nil)
+}
+
+// getQueryParamFromOptions merges the typed options over the parameters found in the context
+func (c *Client) getQueryParamFromOptions(ctx context.Context, options map[string][]string) string {
 	params := make(map[string][]string, 0)
 
 	if parameters, ok := ctx.Value(interfaces.ParametersContext{}).(map[string][]string); ok {
@@ -26,42 +35,178 @@ func (c *Client) getQueryParamFromContext(ctx context.Context) string {
 			params[k] = vs
 		}
 	}
+	for k, vs := range options {
+		klog.V(5).Infof("Option Key/Value: %s = %v\n", k, vs)
+		params[k] = vs
+	}
 
-	// TODO: replace with https://github.com/google/go-querystring
-	// API differs from how go-querystring works
-	//
-	//	go-querystring : []vals -> key=vals[0]&key=vals[1]
-	//	symbl API: []vals -> key=["$vals[0]", "$vals[1]"]
-	//
-	// need to look into switching that behavior in order to use go-querystring lib
-	if len(params) > 1 {
-		queryString := "?"
-		for k, vs := range params {
-			if len(queryString) > 3 {
-				queryString += "&"
-			}
-			if len(vs) == 1 {
-				queryString += fmt.Sprintf("%s=%s", k, vs[0])
-			} else {
-				appended := false
-				for _, v := range vs {
-					if !appended {
-						queryString += fmt.Sprintf("%s=[", k)
-						appended = true
-					} else {
-						queryString += ","
-					}
-					queryString += fmt.Sprintf("%s", v)
-				}
-				if len(vs) > 0 {
-					queryString += "]"
-				}
-			}
-		}
-		klog.V(5).Infof("Final Query String: %s\n", queryString)
-		return queryString
+	queryString := rest.EncodeQueryParams(params)
+	if len(queryString) == 0 {
+		klog.V(6).Infof("Final Query String is Empty\n")
+		return ""
+	}
+
+	queryString = "?" + queryString
+	klog.V(5).Infof("Final Query String: %s\n", queryString)
+	return queryString
+}
+
+// appendQueryString appends the query string to a URI which might already contain parameters
+func appendQueryString(uri, queryString string) string {
+	if strings.Contains(uri, "?") && strings.HasPrefix(queryString, "?") {
+		return uri + "&" + queryString[1:]
+	}
+	return uri + queryString
+}
+
+func messagesParams(opts asyncinterfaces.MessagesOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if opts.Verbose {
+		params["verbose"] = []string{"true"}
+	}
+	if opts.Sentiment {
+		params["sentiment"] = []string{"true"}
+	}
+	redactionParams(params, opts.Redaction)
+
+	return params
+}
+
+func transcriptParams(opts asyncinterfaces.TranscriptOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	redactionParams(params, opts.Redaction)
+
+	return params
+}
+
+// redactionParams encodes entity type lists as JSON arrays, ie exclude=["PERSON_NAME"]
+func redactionParams(params map[string][]string, opts asyncinterfaces.RedactionOptions) {
+	if !opts.Redact {
+		return
+	}
+
+	params["redact"] = []string{"true"}
+	if len(opts.Include) > 0 {
+		byInclude, _ := json.Marshal(opts.Include)
+		params["include"] = []string{string(byInclude)}
+	}
+	if len(opts.Exclude) > 0 {
+		byExclude, _ := json.Marshal(opts.Exclude)
+		params["exclude"] = []string{string(byExclude)}
+	}
+	if len(opts.ReplacementToken) > 0 {
+		params["replacementToken"] = []string{opts.ReplacementToken}
 	}
+}
 
-	klog.V(6).Infof("Final Query String is Empty\n")
-	return ""
+func topicsParams(opts asyncinterfaces.TopicsOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if opts.Sentiment {
+		params["sentiment"] = []string{"true"}
+	}
+	if opts.ParentRefs {
+		params["parentRefs"] = []string{"true"}
+	}
+
+	return params
+}
+
+func questionsParams(opts asyncinterfaces.QuestionsOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if opts.Sentiment {
+		params["sentiment"] = []string{"true"}
+	}
+
+	return params
+}
+
+func entitiesParams(opts asyncinterfaces.EntitiesOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if len(opts.Type) > 0 {
+		params["type"] = []string{opts.Type}
+	}
+	if len(opts.SubType) > 0 {
+		params["subType"] = []string{opts.SubType}
+	}
+
+	return params
+}
+
+func summaryParams(opts asyncinterfaces.SummaryOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if opts.Refresh {
+		params["refresh"] = []string{"true"}
+	}
+
+	return params
+}
+
+func conversationsListParams(opts asyncinterfaces.ConversationsListOptions) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	if opts.Limit > 0 {
+		params["limit"] = []string{strconv.Itoa(opts.Limit)}
+	}
+	if opts.Offset > 0 {
+		params["offset"] = []string{strconv.Itoa(opts.Offset)}
+	}
+	if len(opts.Order) > 0 {
+		params["order"] = []string{opts.Order}
+	}
+	if len(opts.Sort) > 0 {
+		params["sort"] = []string{opts.Sort}
+	}
+	if !opts.StartTime.IsZero() {
+		params["startTime"] = []string{opts.StartTime.UTC().Format(time.RFC3339)}
+	}
+	if !opts.EndTime.IsZero() {
+		params["endTime"] = []string{opts.EndTime.UTC().Format(time.RFC3339)}
+	}
+	if len(opts.ConversationGroupID) > 0 {
+		params["conversationGroupId"] = []string{opts.ConversationGroupID}
+	}
+	if len(opts.Name) > 0 {
+		params["name"] = []string{opts.Name}
+	}
+
+	return params
+}
+
+// validBookmarkOpts checks the fields the platform requires on every bookmark
+func validBookmarkOpts(opts asyncinterfaces.BookmarkOpts) bool {
+	return len(opts.Label) > 0 &&
+		len(opts.User.Name) > 0 &&
+		len(opts.User.UserID) > 0 &&
+		len(opts.User.Email) > 0
+}
+
+// bookmarkRequest fills a BookmarkRequest covering [start, end) rounded out to whole seconds
+func bookmarkRequest(opts asyncinterfaces.BookmarkOpts, description string, start, end time.Duration, refs []asyncinterfaces.MessageRefRequest) asyncinterfaces.BookmarkRequest {
+	if opts.Description != "" {
+		description = opts.Description
+	}
+	if description == "" {
+		description = opts.Label
+	}
+
+	begin := int(start / time.Second)
+	duration := int((end+time.Second-1)/time.Second) - begin
+	if duration < 1 {
+		duration = 1
+	}
+
+	return asyncinterfaces.BookmarkRequest{
+		Label:           opts.Label,
+		Description:     description,
+		User:            opts.User,
+		BeginTimeOffset: begin,
+		Duration:        duration,
+		MessageRefs:     refs,
+	}
 }
@@ -0,0 +1,283 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Async package for processing Async conversations
+*/
+package async
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	validator "gopkg.in/go-playground/validator.v9"
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// NewWebhookHandler creates a WebhookHandler. The callback is optional and is invoked for every
+// valid job status received.
+func NewWebhookHandler(callback asyncinterfaces.JobStatusCallback) *WebhookHandler {
+	return &WebhookHandler{
+		callback: callback,
+		waiters:  make(map[string][]chan *asyncinterfaces.JobStatusEvent),
+		finished: make(map[string]finishedJob),
+	}
+}
+
+// ServeHTTP parses and validates a job status webhook then dispatches it to the callback and
+// anyone waiting on the jobId or conversationId
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	klog.V(6).Infof("WebhookHandler.ServeHTTP ENTER\n")
+
+	if r.Method != http.MethodPost {
+		klog.V(1).Infof("Invalid method: %s\n", r.Method)
+		klog.V(6).Infof("WebhookHandler.ServeHTTP LEAVE\n")
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var event asyncinterfaces.JobStatusEvent
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	err := decoder.Decode(&event)
+	if err != nil {
+		klog.V(1).Infof("json.Decode failed. Err: %v\n", err)
+		klog.V(6).Infof("WebhookHandler.ServeHTTP LEAVE\n")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	v := validator.New()
+	err = v.Struct(event)
+	if err != nil {
+		for _, e := range err.(validator.ValidationErrors) {
+			klog.V(1).Infof("JobStatusEvent validation failed: %v\n", e)
+		}
+		klog.V(6).Infof("WebhookHandler.ServeHTTP LEAVE\n")
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	klog.V(3).Infof("Job %s (conversation %s) status: %s\n", event.JobID, event.ConversationID, event.Status)
+
+	if wh.callback != nil {
+		err = wh.callback.JobStatusMessage(&event)
+		if err != nil {
+			klog.V(1).Infof("JobStatusMessage failed. Err: %v\n", err)
+		}
+	}
+
+	wh.dispatch(&event)
+
+	w.WriteHeader(http.StatusOK)
+	klog.V(6).Infof("WebhookHandler.ServeHTTP LEAVE\n")
+}
+
+// Subscribe returns a channel which receives job status events for the given jobId or conversationId.
+// The channel must be released using Unsubscribe.
+func (wh *WebhookHandler) Subscribe(id string) <-chan *asyncinterfaces.JobStatusEvent {
+	ch := make(chan *asyncinterfaces.JobStatusEvent, 1)
+
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	// already done? deliver right away
+	if job, ok := wh.finished[id]; ok {
+		ch <- job.event
+	}
+	wh.waiters[id] = append(wh.waiters[id], ch)
+
+	return ch
+}
+
+// Unsubscribe releases a channel returned by Subscribe
+func (wh *WebhookHandler) Unsubscribe(id string, ch <-chan *asyncinterfaces.JobStatusEvent) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	waiters := wh.waiters[id]
+	for i, waiter := range waiters {
+		if waiter == ch {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(waiters) == 0 {
+		delete(wh.waiters, id)
+	} else {
+		wh.waiters[id] = waiters
+	}
+}
+
+// WaitForJob blocks until a completed or failed status is received for the given jobId or
+// conversationId, or the context is done
+func (wh *WebhookHandler) WaitForJob(ctx context.Context, id string) (*asyncinterfaces.JobStatusEvent, error) {
+	klog.V(6).Infof("WebhookHandler.WaitForJob ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if id == "" {
+		klog.V(1).Infof("id is empty\n")
+		klog.V(6).Infof("WebhookHandler.WaitForJob LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	ch := wh.Subscribe(id)
+	defer wh.Unsubscribe(id, ch)
+
+	for {
+		select {
+		case <-ctx.Done():
+			klog.V(1).Infof("WaitForJob context done. Err: %v\n", ctx.Err())
+			klog.V(6).Infof("WebhookHandler.WaitForJob LEAVE\n")
+			return nil, ctx.Err()
+		case event := <-ch:
+			if !isJobFinished(event.Status) {
+				klog.V(4).Infof("Job %s status: %s\n", event.JobID, event.Status)
+				continue
+			}
+
+			wh.mu.Lock()
+			delete(wh.finished, event.JobID)
+			delete(wh.finished, event.ConversationID)
+			wh.mu.Unlock()
+
+			klog.V(3).Infof("Job %s finished with status: %s\n", event.JobID, event.Status)
+			klog.V(6).Infof("WebhookHandler.WaitForJob LEAVE\n")
+			return event, nil
+		}
+	}
+}
+
+// WaitForJobCompleteWithWebhook waits for the job to complete using the WebhookHandler instead of
+// polling. If no webhook arrives within TotalWaitInSeconds, the job status is checked once.
+func (c *Client) WaitForJobCompleteWithWebhook(ctx context.Context, wh *WebhookHandler, jobStatusOpts asyncinterfaces.WaitForJobStatusOpts) (bool, error) {
+	klog.V(6).Infof("async.WaitForJobCompleteWithWebhook ENTER\n")
+
+	// validate input
+	v := validator.New()
+	err := v.Struct(jobStatusOpts)
+	if err != nil {
+		for _, e := range err.(validator.ValidationErrors) {
+			klog.V(1).Infof("WaitForJobCompleteWithWebhook validation failed: %v\n", e)
+		}
+		klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+		return false, err
+	}
+	if wh == nil {
+		klog.V(1).Infof("WebhookHandler is nil\n")
+		klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+		return false, ErrInvalidInput
+	}
+
+	// is valid?
+	if jobStatusOpts.TotalWaitInSeconds <= 0 {
+		jobStatusOpts.TotalWaitInSeconds = defaultWaitForCompletion
+		klog.V(3).Infof("Use default wait interval. Input: %d\n", jobStatusOpts.TotalWaitInSeconds)
+	}
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, time.Second*time.Duration(jobStatusOpts.TotalWaitInSeconds))
+	defer cancel()
+
+	event, err := wh.WaitForJob(waitCtx, jobStatusOpts.JobId)
+	if err == nil {
+		if event.Status == JobStatusFailed {
+			klog.V(1).Infof("Job %s failed\n", event.JobID)
+			klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+			return false, ErrJobFailed
+		}
+
+		klog.V(3).Infof("WaitForJobCompleteWithWebhook completed!\n")
+		klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+		return true, nil
+	}
+	if ctx.Err() != nil {
+		klog.V(1).Infof("WaitForJobCompleteWithWebhook context done. Err: %v\n", ctx.Err())
+		klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+		return false, ctx.Err()
+	}
+
+	// webhook might have been missed, check once
+	klog.V(3).Infof("No webhook received for %s. Checking status.\n", jobStatusOpts.JobId)
+	completed, err := c.WaitForJobCompleteOnce(ctx, jobStatusOpts.JobId)
+	if err != nil {
+		klog.V(1).Infof("WaitForJobCompleteOnce failed. Err: %v\n", err)
+		klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+		return false, err
+	}
+
+	klog.V(3).Infof("WaitForJobCompleteWithWebhook completed: %t\n", completed)
+	klog.V(6).Infof("async.WaitForJobCompleteWithWebhook LEAVE\n")
+	return completed, nil
+}
+
+func (wh *WebhookHandler) dispatch(event *asyncinterfaces.JobStatusEvent) {
+	wh.mu.Lock()
+	defer wh.mu.Unlock()
+
+	ids := []string{event.JobID}
+	if event.ConversationID != "" {
+		ids = append(ids, event.ConversationID)
+	}
+
+	for _, id := range ids {
+		// remember finished jobs so a late WaitForJob doesn't miss the webhook
+		if isJobFinished(event.Status) && len(wh.waiters[id]) == 0 {
+			wh.rememberFinished(id, event)
+		}
+
+		for _, ch := range wh.waiters[id] {
+			select {
+			case ch <- event:
+			default:
+				// drop stale status in favor of the latest
+				select {
+				case <-ch:
+				default:
+				}
+				ch <- event
+			}
+		}
+	}
+}
+
+// rememberFinished keeps at most maxFinishedJobs statuses by evicting the oldest. must be called
+// with wh.mu held.
+func (wh *WebhookHandler) rememberFinished(id string, event *asyncinterfaces.JobStatusEvent) {
+	if _, ok := wh.finished[id]; !ok && len(wh.finished) >= maxFinishedJobs {
+		var oldestID string
+		var oldest time.Time
+		for key, job := range wh.finished {
+			if oldestID == "" || job.received.Before(oldest) {
+				oldestID = key
+				oldest = job.received
+			}
+		}
+
+		klog.V(4).Infof("Evicting finished job status: %s\n", oldestID)
+		delete(wh.finished, oldestID)
+	}
+
+	wh.finished[id] = finishedJob{
+		event:    event,
+		received: time.Now(),
+	}
+}
+
+func isJobFinished(status string) bool {
+	return status == JobStatusComplete || status == JobStatusFailed
+}
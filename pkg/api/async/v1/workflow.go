@@ -0,0 +1,240 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Async package for processing Async conversations
+*/
+package async
+
+import (
+	"context"
+	"sync"
+
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+var (
+	defaultInsightTypes = []string{
+		asyncinterfaces.InsightTypeMessages,
+		asyncinterfaces.InsightTypeTopics,
+		asyncinterfaces.InsightTypeQuestions,
+		asyncinterfaces.InsightTypeActionItems,
+		asyncinterfaces.InsightTypeFollowUps,
+		asyncinterfaces.InsightTypeSummary,
+	}
+)
+
+// ProcessFile posts a file, waits for processing to complete and then fetches the requested insights
+func (c *Client) ProcessFile(ctx context.Context, filePath string, ufRequest asyncinterfaces.AsyncURLFileRequest, opts asyncinterfaces.WorkflowOpts) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.ProcessFile ENTER\n")
+
+	jobConvo, err := c.PostFileWithOptions(ctx, filePath, ufRequest)
+	if err != nil {
+		klog.V(1).Infof("PostFileWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessFile LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("async.ProcessFile LEAVE\n")
+	return c.waitAndFetchInsights(ctx, jobConvo, opts)
+}
+
+// ProcessURL posts a URL, waits for processing to complete and then fetches the requested insights
+func (c *Client) ProcessURL(ctx context.Context, ufRequest asyncinterfaces.AsyncURLFileRequest, opts asyncinterfaces.WorkflowOpts) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.ProcessURL ENTER\n")
+
+	jobConvo, err := c.PostURLWithOptions(ctx, ufRequest)
+	if err != nil {
+		klog.V(1).Infof("PostURLWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessURL LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("async.ProcessURL LEAVE\n")
+	return c.waitAndFetchInsights(ctx, jobConvo, opts)
+}
+
+// ProcessText posts text, waits for processing to complete and then fetches the requested insights
+func (c *Client) ProcessText(ctx context.Context, textRequest asyncinterfaces.AsyncTextRequest, opts asyncinterfaces.WorkflowOpts) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.ProcessText ENTER\n")
+
+	jobConvo, err := c.PostTextWithOptions(ctx, textRequest)
+	if err != nil {
+		klog.V(1).Infof("PostTextWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("async.ProcessText LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("async.ProcessText LEAVE\n")
+	return c.waitAndFetchInsights(ctx, jobConvo, opts)
+}
+
+// FetchInsights concurrently fetches the requested insight types for a processed conversation.
+// Failures are reported per insight type in ConversationInsights.Errors.
+func (c *Client) FetchInsights(ctx context.Context, conversationId string, insightTypes []string) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.FetchInsights ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if conversationId == "" {
+		klog.V(1).Infof("conversationId is empty\n")
+		klog.V(6).Infof("async.FetchInsights LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if len(insightTypes) == 0 {
+		insightTypes = defaultInsightTypes
+	}
+
+	insights := &ConversationInsights{
+		ConversationID: conversationId,
+		Errors:         make(map[string]error),
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, insightType := range insightTypes {
+		wg.Add(1)
+		go func(insightType string) {
+			defer wg.Done()
+
+			err := c.fetchInsight(ctx, conversationId, insightType, insights, &mu)
+			if err != nil {
+				klog.V(1).Infof("Fetching %s failed. Err: %v\n", insightType, err)
+				mu.Lock()
+				insights.Errors[insightType] = err
+				mu.Unlock()
+			}
+		}(insightType)
+	}
+	wg.Wait()
+
+	klog.V(3).Infof("FetchInsights completed with %d errors\n", len(insights.Errors))
+	klog.V(6).Infof("async.FetchInsights LEAVE\n")
+	return insights, nil
+}
+
+func (c *Client) waitAndFetchInsights(ctx context.Context, jobConvo *JobConversation, opts asyncinterfaces.WorkflowOpts) (*ConversationInsights, error) {
+	klog.V(6).Infof("async.waitAndFetchInsights ENTER\n")
+
+	completed, err := c.WaitForJobComplete(ctx, asyncinterfaces.WaitForJobStatusOpts{
+		JobId:              jobConvo.JobID,
+		TotalWaitInSeconds: opts.TotalWaitInSeconds,
+		WaitInSeconds:      opts.WaitInSeconds,
+	})
+	if err != nil {
+		klog.V(1).Infof("WaitForJobComplete failed. Err: %v\n", err)
+		klog.V(6).Infof("async.waitAndFetchInsights LEAVE\n")
+		return nil, err
+	}
+	if !completed {
+		klog.V(1).Infof("WaitForJobComplete failed to complete\n")
+		klog.V(6).Infof("async.waitAndFetchInsights LEAVE\n")
+		return nil, ErrJobStatusTimeout
+	}
+
+	insights, err := c.FetchInsights(ctx, jobConvo.ConversationID, opts.Insights)
+	if err != nil {
+		klog.V(1).Infof("FetchInsights failed. Err: %v\n", err)
+		klog.V(6).Infof("async.waitAndFetchInsights LEAVE\n")
+		return nil, err
+	}
+	insights.JobID = jobConvo.JobID
+
+	klog.V(6).Infof("async.waitAndFetchInsights LEAVE\n")
+	return insights, nil
+}
+
+func (c *Client) fetchInsight(ctx context.Context, conversationId, insightType string, insights *ConversationInsights, mu *sync.Mutex) error {
+	switch insightType {
+	case asyncinterfaces.InsightTypeMessages:
+		result, err := c.GetMessages(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Messages = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeTopics:
+		result, err := c.GetTopics(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Topics = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeQuestions:
+		result, err := c.GetQuestions(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Questions = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeActionItems:
+		result, err := c.GetActionItems(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.ActionItems = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeFollowUps:
+		result, err := c.GetFollowUps(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.FollowUps = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeSummary:
+		result, err := c.GetSummary(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Summary = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeEntities:
+		result, err := c.GetEntities(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Entities = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeAnalytics:
+		result, err := c.GetAnalytics(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Analytics = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeTrackers:
+		result, err := c.GetTracker(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Trackers = result
+		mu.Unlock()
+	case asyncinterfaces.InsightTypeMembers:
+		result, err := c.GetMembers(ctx, conversationId)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		insights.Members = result
+		mu.Unlock()
+	default:
+		return ErrUnknownInsightType
+	}
+
+	return nil
+}
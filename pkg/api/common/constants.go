@@ -11,4 +11,7 @@ const (
 	AudioTypeMP3  string = "mp3"
 	AudioTypeMpeg string = "mpeg"
 	AudioTypeWav  string = "wav"
+
+	MediaTypeAudio string = "audio"
+	MediaTypeVideo string = "video"
 )
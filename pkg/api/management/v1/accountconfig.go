@@ -0,0 +1,396 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// ExportConfig snapshots all trackers, custom entities and conversation groups in the account
+func (m *Management) ExportConfig(ctx context.Context) (*AccountConfig, error) {
+	klog.V(6).Infof("mgmt.ExportConfig ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	trackers, err := m.GetTrackers(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetTrackers failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ExportConfig LEAVE\n")
+		return nil, err
+	}
+
+	entities, err := m.GetEntites(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetEntites failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ExportConfig LEAVE\n")
+		return nil, err
+	}
+
+	groups, err := m.GetConversationGroups(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetConversationGroups failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ExportConfig LEAVE\n")
+		return nil, err
+	}
+
+	config := &AccountConfig{
+		Version:    AccountConfigVersion,
+		ExportedAt: time.Now().UTC(),
+		Trackers:   trackers.Trackers,
+		Entities:   entities.Entities,
+		Groups:     groups.Groups,
+	}
+
+	klog.V(3).Infof("ExportConfig found %d trackers, %d entities and %d groups\n",
+		len(config.Trackers), len(config.Entities), len(config.Groups))
+	klog.V(6).Infof("mgmt.ExportConfig LEAVE\n")
+	return config, nil
+}
+
+// ReadAccountConfig decodes an AccountConfig written by WriteAccountConfig
+func ReadAccountConfig(r io.Reader) (*AccountConfig, error) {
+	klog.V(6).Infof("mgmt.ReadAccountConfig ENTER\n")
+
+	var config AccountConfig
+	err := json.NewDecoder(r).Decode(&config)
+	if err != nil {
+		klog.V(1).Infof("json.Decode failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ReadAccountConfig LEAVE\n")
+		return nil, err
+	}
+	if config.Version > AccountConfigVersion {
+		klog.V(1).Infof("AccountConfig version %d is not supported\n", config.Version)
+		klog.V(6).Infof("mgmt.ReadAccountConfig LEAVE\n")
+		return nil, ErrUnsupportedVersion
+	}
+
+	klog.V(6).Infof("mgmt.ReadAccountConfig LEAVE\n")
+	return &config, nil
+}
+
+// WriteAccountConfig encodes the AccountConfig as indented JSON
+func WriteAccountConfig(w io.Writer, config *AccountConfig) error {
+	if config == nil {
+		return ErrInvalidInput
+	}
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(config)
+}
+
+// ImportConfig restores an AccountConfig into the account. Failures restoring individual
+// definitions are collected in the result and do not stop the import.
+func (m *Management) ImportConfig(ctx context.Context, config *AccountConfig, opts ImportConfigOpts) (*ImportConfigResult, error) {
+	klog.V(6).Infof("mgmt.ImportConfig ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// validate input
+	if config == nil {
+		klog.V(1).Infof("config is nil\n")
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if config.Version > AccountConfigVersion {
+		klog.V(1).Infof("AccountConfig version %d is not supported\n", config.Version)
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, ErrUnsupportedVersion
+	}
+	switch opts.Conflict {
+	case "":
+		opts.Conflict = ConflictSkip
+	case ConflictSkip, ConflictOverwrite, ConflictRename:
+	default:
+		klog.V(1).Infof("Conflict strategy %s is unknown\n", opts.Conflict)
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, ErrUnknownConflictStrategy
+	}
+	if opts.RenameSuffix == "" {
+		opts.RenameSuffix = defaultRenameSuffix
+	}
+
+	result := &ImportConfigResult{
+		TrackerIDs: make(map[string]string),
+		EntityIDs:  make(map[string]string),
+		GroupIDs:   make(map[string]string),
+	}
+
+	err := m.importTrackers(ctx, config.Trackers, opts, result)
+	if err != nil {
+		klog.V(1).Infof("importTrackers failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, err
+	}
+
+	err = m.importEntities(ctx, config.Entities, opts, result)
+	if err != nil {
+		klog.V(1).Infof("importEntities failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, err
+	}
+
+	err = m.importGroups(ctx, config.Groups, opts, result)
+	if err != nil {
+		klog.V(1).Infof("importGroups failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("ImportConfig created %d, updated %d, skipped %d, failed %d\n",
+		result.Created, result.Updated, result.Skipped, len(result.Errors))
+	klog.V(6).Infof("mgmt.ImportConfig LEAVE\n")
+	return result, nil
+}
+
+func (m *Management) importTrackers(ctx context.Context, trackers []mgmtinterfaces.Tracker, opts ImportConfigOpts, result *ImportConfigResult) error {
+	current, err := m.GetTrackers(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]mgmtinterfaces.Tracker, len(current.Trackers))
+	for _, tracker := range current.Trackers {
+		existing[tracker.Name] = tracker
+	}
+
+	for _, tracker := range trackers {
+		request := mgmtinterfaces.TrackerRequest{
+			Name:        tracker.Name,
+			Description: tracker.Description,
+			Categories:  tracker.Categories,
+			Languages:   tracker.Languages,
+			Vocabulary:  tracker.Vocabulary,
+		}
+		if len(request.Languages) == 0 {
+			request.Languages = []string{mgmtinterfaces.TrackerLanguageDefault}
+		}
+
+		have, conflict := existing[tracker.Name]
+		if conflict {
+			switch opts.Conflict {
+			case ConflictSkip:
+				result.TrackerIDs[tracker.ID] = have.ID
+				result.Skipped++
+				continue
+			case ConflictOverwrite:
+				update, err := trackerPatch(have, request).Build()
+				if err == nil && len(update.TrackerArray) == 0 {
+					result.TrackerIDs[tracker.ID] = have.ID
+					result.Skipped++
+					continue
+				}
+				if err == nil {
+					_, err = m.UpdateTracker(ctx, have.ID, *update)
+				}
+				if err != nil {
+					klog.V(1).Infof("Overwrite tracker %s failed. Err: %v\n", tracker.Name, err)
+					result.Errors = append(result.Errors, fmt.Errorf("tracker %s: %w", tracker.Name, err))
+					continue
+				}
+				result.TrackerIDs[tracker.ID] = have.ID
+				result.Updated++
+				continue
+			case ConflictRename:
+				request.Name = uniqueName(tracker.Name, opts.RenameSuffix, func(name string) bool {
+					_, ok := existing[name]
+					return ok
+				})
+			}
+		}
+
+		created, err := m.CreateTracker(ctx, request)
+		if err != nil {
+			klog.V(1).Infof("CreateTracker %s failed. Err: %v\n", request.Name, err)
+			result.Errors = append(result.Errors, fmt.Errorf("tracker %s: %w", request.Name, err))
+			continue
+		}
+		existing[created.Tracker.Name] = created.Tracker
+		result.TrackerIDs[tracker.ID] = created.Tracker.ID
+		result.Created++
+	}
+
+	return nil
+}
+
+func (m *Management) importEntities(ctx context.Context, entities []mgmtinterfaces.Entity, opts ImportConfigOpts, result *ImportConfigResult) error {
+	current, err := m.GetEntites(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]mgmtinterfaces.Entity, len(current.Entities))
+	for _, entity := range current.Entities {
+		existing[entityKey(entity.Type, entity.SubType, entity.Category)] = entity
+	}
+
+	// the create response does not carry the new IDs so they are looked up afterwards
+	created := make(map[string][]string)
+	pending := make(map[string]string)
+	request := mgmtinterfaces.CreateEntityRequest{}
+
+	for _, entity := range entities {
+		key := entityKey(entity.Type, entity.SubType, entity.Category)
+
+		// duplicates in the input map to the entity already being created
+		if createKey, ok := pending[key]; ok {
+			klog.V(4).Infof("Duplicate entity %s in the input\n", key)
+			created[createKey] = append(created[createKey], entity.ID)
+			result.Skipped++
+			continue
+		}
+		inputKey := key
+
+		have, conflict := existing[key]
+		if conflict {
+			switch opts.Conflict {
+			case ConflictSkip:
+				result.EntityIDs[entity.ID] = have.ID
+				result.Skipped++
+				continue
+			case ConflictOverwrite:
+				if sameSet(have.Values, entity.Values) {
+					result.EntityIDs[entity.ID] = have.ID
+					result.Skipped++
+					continue
+				}
+
+				update := have
+				update.Values = entity.Values
+				_, err := m.UpdateEntity(ctx, have.ID, update)
+				if err != nil {
+					klog.V(1).Infof("Overwrite entity %s failed. Err: %v\n", key, err)
+					result.Errors = append(result.Errors, fmt.Errorf("entity %s: %w", key, err))
+					continue
+				}
+				existing[key] = update
+				result.EntityIDs[entity.ID] = have.ID
+				result.Updated++
+				continue
+			case ConflictRename:
+				entity.SubType = uniqueName(entity.SubType, opts.RenameSuffix, func(subType string) bool {
+					_, ok := existing[entityKey(entity.Type, subType, entity.Category)]
+					return ok
+				})
+				key = entityKey(entity.Type, entity.SubType, entity.Category)
+			}
+		}
+
+		// reserve the key so a renamed entity can't take it
+		existing[key] = mgmtinterfaces.Entity{}
+		created[key] = []string{entity.ID}
+		pending[inputKey] = key
+		request.EntityArray = append(request.EntityArray, mgmtinterfaces.EntityRequest{
+			Type:     entity.Type,
+			SubType:  entity.SubType,
+			Category: entity.Category,
+			Values:   entity.Values,
+		})
+	}
+
+	if len(request.EntityArray) == 0 {
+		return nil
+	}
+
+	_, err = m.CreateEntity(ctx, request)
+	if err != nil {
+		klog.V(1).Infof("CreateEntity failed. Err: %v\n", err)
+		result.Errors = append(result.Errors, fmt.Errorf("entities: %w", err))
+		return nil
+	}
+	result.Created += len(request.EntityArray)
+
+	current, err = m.GetEntites(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetEntites failed. Err: %v\n", err)
+		result.Errors = append(result.Errors, fmt.Errorf("entities: %w", err))
+		return nil
+	}
+	for _, entity := range current.Entities {
+		for _, oldId := range created[entityKey(entity.Type, entity.SubType, entity.Category)] {
+			result.EntityIDs[oldId] = entity.ID
+		}
+	}
+
+	return nil
+}
+
+func (m *Management) importGroups(ctx context.Context, groups []mgmtinterfaces.Group, opts ImportConfigOpts, result *ImportConfigResult) error {
+	current, err := m.GetConversationGroups(ctx)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]mgmtinterfaces.Group, len(current.Groups))
+	for _, group := range current.Groups {
+		existing[group.Name] = group
+	}
+
+	for _, group := range groups {
+		request := group
+		request.ID = ""
+
+		have, conflict := existing[group.Name]
+		if conflict {
+			switch opts.Conflict {
+			case ConflictSkip:
+				result.GroupIDs[group.ID] = have.ID
+				result.Skipped++
+				continue
+			case ConflictOverwrite:
+				request.ID = have.ID
+				_, err := m.UpdateConversationGroup(ctx, request)
+				if err != nil {
+					klog.V(1).Infof("Overwrite group %s failed. Err: %v\n", group.Name, err)
+					result.Errors = append(result.Errors, fmt.Errorf("group %s: %w", group.Name, err))
+					continue
+				}
+				result.GroupIDs[group.ID] = have.ID
+				result.Updated++
+				continue
+			case ConflictRename:
+				request.Name = uniqueName(group.Name, opts.RenameSuffix, func(name string) bool {
+					_, ok := existing[name]
+					return ok
+				})
+			}
+		}
+
+		created, err := m.CreateConversationGroup(ctx, request)
+		if err != nil {
+			klog.V(1).Infof("CreateConversationGroup %s failed. Err: %v\n", request.Name, err)
+			result.Errors = append(result.Errors, fmt.Errorf("group %s: %w", request.Name, err))
+			continue
+		}
+		existing[created.Group.Name] = created.Group
+		result.GroupIDs[group.ID] = created.Group.ID
+		result.Created++
+	}
+
+	return nil
+}
+
+// uniqueName appends the suffix, and a counter when needed, until the name is not taken
+func uniqueName(name, suffix string, taken func(string) bool) string {
+	candidate := name + suffix
+	for i := 2; taken(candidate); i++ {
+		candidate = name + suffix + "-" + strconv.Itoa(i)
+	}
+	return candidate
+}
@@ -8,7 +8,60 @@ import (
 	"errors"
 )
 
+const (
+	// actions in a sync plan
+	PlanActionCreate string = "create"
+	PlanActionUpdate string = "update"
+	PlanActionDelete string = "delete"
+
+	// AccountConfigVersion is the version of the AccountConfig document written by ExportConfig
+	AccountConfigVersion int = 1
+
+	// how ImportConfig handles a definition which already exists in the account
+	ConflictSkip      string = "skip"
+	ConflictOverwrite string = "overwrite"
+	ConflictRename    string = "rename"
+
+	defaultRenameSuffix string = "-imported"
+
+	// conversation group criteria fields
+	CriteriaFieldMetadata  string = "conversation.metadata."
+	CriteriaFieldStartTime string = "conversation.startTime"
+
+	// conversation group criteria operators
+	CriteriaOperatorEqual          string = "=="
+	CriteriaOperatorNotEqual       string = "!="
+	CriteriaOperatorGreaterOrEqual string = ">="
+	CriteriaOperatorLess           string = "<"
+	CriteriaOperatorAnd            string = ";"
+	CriteriaOperatorOr             string = ","
+
+	// characters which require a criteria value to be quoted
+	criteriaReservedChars string = "\"'();,=!~<> \t"
+)
+
 var (
 	// ErrInvalidInput required input was not found
 	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrDuplicateDefinition the desired definitions contain the same name or key more than once
+	ErrDuplicateDefinition = errors.New("duplicate entry in desired definitions")
+
+	// ErrVocabularyExists the phrase is already in the tracker vocabulary
+	ErrVocabularyExists = errors.New("phrase already exists in the tracker vocabulary")
+
+	// ErrVocabularyNotFound the phrase is not in the tracker vocabulary
+	ErrVocabularyNotFound = errors.New("phrase not found in the tracker vocabulary")
+
+	// ErrInvalidCriteria the conversation group criteria is incomplete or malformed
+	ErrInvalidCriteria = errors.New("invalid conversation group criteria")
+
+	// ErrUnsupportedVersion the account config was written by a newer version of this package
+	ErrUnsupportedVersion = errors.New("unsupported account config version")
+
+	// ErrUnknownConflictStrategy the conflict strategy is not one of the Conflict constants
+	ErrUnknownConflictStrategy = errors.New("unknown conflict strategy")
+
+	// ErrInvalidCSV the CSV is missing required columns
+	ErrInvalidCSV = errors.New("csv requires type, subType, category and value columns")
 )
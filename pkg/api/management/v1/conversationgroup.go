@@ -13,6 +13,8 @@ import (
 	validator "gopkg.in/go-playground/validator.v9"
 	klog "k8s.io/klog/v2"
 
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
 	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
 	version "github.com/symblai/symbl-go-sdk/pkg/api/version"
 	interfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
@@ -279,3 +281,38 @@ func (m *Management) DeleteConversationGroup(ctx context.Context, conversationGr
 	klog.V(6).Infof("mgmt.DeleteConversationGroup LEAVE\n")
 	return nil
 }
+
+// GetConversationGroupConversations lists every conversation in the conversation group
+func (m *Management) GetConversationGroupConversations(ctx context.Context, conversationGroupId string) ([]asyncinterfaces.Conversation, error) {
+	klog.V(6).Infof("mgmt.GetConversationGroupConversations ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	// validate input
+	if conversationGroupId == "" {
+		klog.V(1).Infof("conversationGroupId is empty\n")
+		klog.V(6).Infof("mgmt.GetConversationGroupConversations LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	conversations := make([]asyncinterfaces.Conversation, 0)
+
+	it := async.New(m.RestClient).ListConversations(ctx, asyncinterfaces.ConversationsListOptions{
+		ConversationGroupID: conversationGroupId,
+	})
+	for it.Next() {
+		conversations = append(conversations, *it.Conversation())
+	}
+	if err := it.Err(); err != nil {
+		klog.V(1).Infof("ListConversations failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.GetConversationGroupConversations LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("GetConversationGroupConversations found %d conversations\n", len(conversations))
+	klog.V(6).Infof("mgmt.GetConversationGroupConversations LEAVE\n")
+	return conversations, nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	klog "k8s.io/klog/v2"
+)
+
+var metadataKeyRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+(\.[A-Za-z0-9_-]+)*$`)
+
+// MetadataEquals matches conversations where the metadata key has the value
+func MetadataEquals(key, value string) Criterion {
+	return metadataCriterion(key, CriteriaOperatorEqual, value)
+}
+
+// MetadataNotEquals matches conversations where the metadata key does not have the value
+func MetadataNotEquals(key, value string) Criterion {
+	return metadataCriterion(key, CriteriaOperatorNotEqual, value)
+}
+
+// StartTimeAfter matches conversations which started at or after t
+func StartTimeAfter(t time.Time) Criterion {
+	return startTimeCriterion(CriteriaOperatorGreaterOrEqual, t)
+}
+
+// StartTimeBefore matches conversations which started before t
+func StartTimeBefore(t time.Time) Criterion {
+	return startTimeCriterion(CriteriaOperatorLess, t)
+}
+
+// StartTimeBetween matches conversations which started in [from, to)
+func StartTimeBetween(from, to time.Time) Criterion {
+	if !from.Before(to) {
+		klog.V(1).Infof("StartTimeBetween from %v is not before to %v\n", from, to)
+		return Criterion{err: ErrInvalidCriteria}
+	}
+	return And(StartTimeAfter(from), StartTimeBefore(to))
+}
+
+// And matches conversations which match all of the criteria
+func And(criteria ...Criterion) Criterion {
+	return Criterion{operator: CriteriaOperatorAnd, children: criteria}
+}
+
+// Or matches conversations which match any of the criteria
+func Or(criteria ...Criterion) Criterion {
+	return Criterion{operator: CriteriaOperatorOr, children: criteria}
+}
+
+// Build validates the criteria and serializes it for Group.Criteria
+func (c Criterion) Build() (string, error) {
+	err := c.validate()
+	if err != nil {
+		return "", err
+	}
+	return c.format(""), nil
+}
+
+// String serializes the criteria. Use Build to also validate it.
+func (c Criterion) String() string {
+	return c.format("")
+}
+
+func (c Criterion) validate() error {
+	if c.err != nil {
+		return c.err
+	}
+	if c.operator == "" {
+		klog.V(1).Infof("Criterion is empty\n")
+		return ErrInvalidCriteria
+	}
+	if c.isComposite() {
+		if len(c.children) == 0 {
+			klog.V(1).Infof("Criterion %q has no children\n", c.operator)
+			return ErrInvalidCriteria
+		}
+		for _, child := range c.children {
+			if err := child.validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// format serializes the criterion, adding parentheses when it is nested in a different operator
+func (c Criterion) format(parent string) string {
+	if !c.isComposite() {
+		return c.field + c.operator + quoteCriteriaValue(c.value)
+	}
+	if len(c.children) == 1 {
+		return c.children[0].format(parent)
+	}
+
+	parts := make([]string, 0, len(c.children))
+	for _, child := range c.children {
+		parts = append(parts, child.format(c.operator))
+	}
+	s := strings.Join(parts, c.operator)
+	if parent != "" && parent != c.operator {
+		s = "(" + s + ")"
+	}
+	return s
+}
+
+func (c Criterion) isComposite() bool {
+	return c.operator == CriteriaOperatorAnd || c.operator == CriteriaOperatorOr
+}
+
+func metadataCriterion(key, operator, value string) Criterion {
+	if !metadataKeyRegex.MatchString(key) {
+		klog.V(1).Infof("Metadata key %q is invalid\n", key)
+		return Criterion{err: ErrInvalidCriteria}
+	}
+	if value == "" {
+		klog.V(1).Infof("Metadata value for %q is empty\n", key)
+		return Criterion{err: ErrInvalidCriteria}
+	}
+	return Criterion{
+		operator: operator,
+		field:    CriteriaFieldMetadata + key,
+		value:    value,
+	}
+}
+
+func startTimeCriterion(operator string, t time.Time) Criterion {
+	if t.IsZero() {
+		klog.V(1).Infof("Start time is zero\n")
+		return Criterion{err: ErrInvalidCriteria}
+	}
+	return Criterion{
+		operator: operator,
+		field:    CriteriaFieldStartTime,
+		value:    t.UTC().Format(time.RFC3339),
+	}
+}
+
+// quoteCriteriaValue quotes values containing reserved characters
+func quoteCriteriaValue(value string) string {
+	if !strings.ContainsAny(value, criteriaReservedChars) {
+		return value
+	}
+	value = strings.ReplaceAll(value, `\`, `\\`)
+	value = strings.ReplaceAll(value, `"`, `\"`)
+	return `"` + value + `"`
+}
@@ -0,0 +1,324 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// LoadEntities reads entity definitions from YAML or JSON. The document is either a list of
+// entities or an object with an "entities" list.
+func LoadEntities(r io.Reader) ([]mgmtinterfaces.EntityRequest, error) {
+	klog.V(6).Infof("mgmt.LoadEntities ENTER\n")
+
+	var entities []mgmtinterfaces.EntityRequest
+	err := decodeDefinitions(r, "entities", &entities)
+	if err != nil {
+		klog.V(1).Infof("decodeDefinitions failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.LoadEntities LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("LoadEntities found %d entities\n", len(entities))
+	klog.V(6).Infof("mgmt.LoadEntities LEAVE\n")
+	return entities, nil
+}
+
+// LoadEntitiesCSV reads entity values from a CSV with a header row containing type, subType,
+// category and value columns. Rows with the same type, subType and category are merged.
+func LoadEntitiesCSV(r io.Reader) ([]mgmtinterfaces.EntityRequest, error) {
+	klog.V(6).Infof("mgmt.LoadEntitiesCSV ENTER\n")
+
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		klog.V(1).Infof("csv.Read failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.LoadEntitiesCSV LEAVE\n")
+		return nil, err
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"type", "subtype", "category", "value"} {
+		if _, ok := columns[name]; !ok {
+			klog.V(1).Infof("CSV column %s not found\n", name)
+			klog.V(6).Infof("mgmt.LoadEntitiesCSV LEAVE\n")
+			return nil, ErrInvalidCSV
+		}
+	}
+
+	entities := make([]mgmtinterfaces.EntityRequest, 0)
+	index := make(map[string]int)
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			klog.V(1).Infof("csv.Read failed. Err: %v\n", err)
+			klog.V(6).Infof("mgmt.LoadEntitiesCSV LEAVE\n")
+			return nil, err
+		}
+
+		entity := mgmtinterfaces.EntityRequest{
+			Type:     strings.TrimSpace(record[columns["type"]]),
+			SubType:  strings.TrimSpace(record[columns["subtype"]]),
+			Category: strings.TrimSpace(record[columns["category"]]),
+		}
+		value := strings.TrimSpace(record[columns["value"]])
+
+		key := entityKey(entity.Type, entity.SubType, entity.Category)
+		i, ok := index[key]
+		if !ok {
+			i = len(entities)
+			index[key] = i
+			entities = append(entities, entity)
+		}
+		if value != "" {
+			entities[i].Values = append(entities[i].Values, value)
+		}
+	}
+
+	klog.V(3).Infof("LoadEntitiesCSV found %d entities\n", len(entities))
+	klog.V(6).Infof("mgmt.LoadEntitiesCSV LEAVE\n")
+	return entities, nil
+}
+
+// DiffEntities computes the changes to turn the current entities into the desired ones. Entities
+// are matched by type, subType and category.
+func DiffEntities(current []mgmtinterfaces.Entity, desired []mgmtinterfaces.EntityRequest, prune bool) (*EntityPlan, error) {
+	existing := make(map[string]mgmtinterfaces.Entity, len(current))
+	for _, entity := range current {
+		existing[entityKey(entity.Type, entity.SubType, entity.Category)] = entity
+	}
+
+	plan := &EntityPlan{}
+	seen := make(map[string]bool, len(desired))
+
+	for _, want := range desired {
+		if want.Type == "" || want.SubType == "" || want.Category == "" {
+			klog.V(1).Infof("Entity type, subType or category is empty\n")
+			return nil, ErrInvalidInput
+		}
+
+		key := entityKey(want.Type, want.SubType, want.Category)
+		if seen[key] {
+			klog.V(1).Infof("Entity %s is defined more than once\n", key)
+			return nil, ErrDuplicateDefinition
+		}
+		seen[key] = true
+
+		have, ok := existing[key]
+		if !ok {
+			request := want
+			plan.Changes = append(plan.Changes, EntityChange{
+				Action: PlanActionCreate,
+				Key:    key,
+				Create: &request,
+				Added:  want.Values,
+			})
+			continue
+		}
+
+		added, removed := diffSets(have.Values, want.Values)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		update := have
+		update.Values = want.Values
+		plan.Changes = append(plan.Changes, EntityChange{
+			Action:   PlanActionUpdate,
+			Key:      key,
+			EntityID: have.ID,
+			Update:   &update,
+			Added:    added,
+			Removed:  removed,
+		})
+	}
+
+	if prune {
+		for _, entity := range current {
+			key := entityKey(entity.Type, entity.SubType, entity.Category)
+			if seen[key] {
+				continue
+			}
+			plan.Changes = append(plan.Changes, EntityChange{
+				Action:   PlanActionDelete,
+				Key:      key,
+				EntityID: entity.ID,
+				Removed:  entity.Values,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanEntities diffs the desired entities against the custom entities on the platform
+func (m *Management) PlanEntities(ctx context.Context, desired []mgmtinterfaces.EntityRequest, opts SyncOpts) (*EntityPlan, error) {
+	klog.V(6).Infof("mgmt.PlanEntities ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	current, err := m.GetEntites(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetEntites failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PlanEntities LEAVE\n")
+		return nil, err
+	}
+
+	plan, err := DiffEntities(current.Entities, desired, opts.Prune)
+	if err != nil {
+		klog.V(1).Infof("DiffEntities failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PlanEntities LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("PlanEntities found %d changes\n", len(plan.Changes))
+	klog.V(6).Infof("mgmt.PlanEntities LEAVE\n")
+	return plan, nil
+}
+
+// ApplyEntityPlan applies the changes and stops at the first failure. New entities are created
+// with a single bulk request. The outcome of each change is recorded in the plan.
+func (m *Management) ApplyEntityPlan(ctx context.Context, plan *EntityPlan) error {
+	klog.V(6).Infof("mgmt.ApplyEntityPlan ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if plan == nil {
+		klog.V(1).Infof("plan is nil\n")
+		klog.V(6).Infof("mgmt.ApplyEntityPlan LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	// bulk create
+	creates := make([]int, 0)
+	request := mgmtinterfaces.CreateEntityRequest{}
+	for i, change := range plan.Changes {
+		if change.Action == PlanActionCreate {
+			creates = append(creates, i)
+			request.EntityArray = append(request.EntityArray, *change.Create)
+		}
+	}
+	if len(creates) > 0 {
+		_, err := m.CreateEntity(ctx, request)
+		for _, i := range creates {
+			plan.Changes[i].Applied = err == nil
+			plan.Changes[i].Err = err
+		}
+		if err != nil {
+			klog.V(1).Infof("CreateEntity failed. Err: %v\n", err)
+			klog.V(6).Infof("mgmt.ApplyEntityPlan LEAVE\n")
+			return err
+		}
+		klog.V(3).Infof("Created %d entities\n", len(creates))
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+
+		var err error
+		switch change.Action {
+		case PlanActionCreate:
+			continue
+		case PlanActionUpdate:
+			_, err = m.UpdateEntity(ctx, change.EntityID, *change.Update)
+		case PlanActionDelete:
+			err = m.DeleteEntity(ctx, change.EntityID)
+		default:
+			err = ErrInvalidInput
+		}
+
+		if err != nil {
+			klog.V(1).Infof("Failed to %s entity %s. Err: %v\n", change.Action, change.Key, err)
+			klog.V(6).Infof("mgmt.ApplyEntityPlan LEAVE\n")
+			change.Err = err
+			return err
+		}
+		change.Applied = true
+		klog.V(3).Infof("Applied %s entity %s\n", change.Action, change.Key)
+	}
+
+	klog.V(6).Infof("mgmt.ApplyEntityPlan LEAVE\n")
+	return nil
+}
+
+// SyncEntities reconciles the custom entities on the platform with the desired entities. With
+// DryRun the plan is returned without being applied.
+func (m *Management) SyncEntities(ctx context.Context, desired []mgmtinterfaces.EntityRequest, opts SyncOpts) (*EntityPlan, error) {
+	klog.V(6).Infof("mgmt.SyncEntities ENTER\n")
+
+	plan, err := m.PlanEntities(ctx, desired, opts)
+	if err != nil {
+		klog.V(1).Infof("PlanEntities failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.SyncEntities LEAVE\n")
+		return nil, err
+	}
+
+	if opts.DryRun {
+		klog.V(3).Infof("DryRun plan:\n%s", plan)
+		klog.V(6).Infof("mgmt.SyncEntities LEAVE\n")
+		return plan, nil
+	}
+
+	err = m.ApplyEntityPlan(ctx, plan)
+	if err != nil {
+		klog.V(1).Infof("ApplyEntityPlan failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.SyncEntities LEAVE\n")
+		return plan, err
+	}
+
+	klog.V(6).Infof("mgmt.SyncEntities LEAVE\n")
+	return plan, nil
+}
+
+// String renders the plan for dry-run output
+func (p *EntityPlan) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case PlanActionCreate:
+			fmt.Fprintf(&b, "+ create entity %s\n", change.Key)
+		case PlanActionUpdate:
+			fmt.Fprintf(&b, "~ update entity %s (%s)\n", change.Key, change.EntityID)
+		case PlanActionDelete:
+			fmt.Fprintf(&b, "- delete entity %s (%s)\n", change.Key, change.EntityID)
+			continue
+		}
+		for _, value := range change.Added {
+			fmt.Fprintf(&b, "    + %q\n", value)
+		}
+		for _, value := range change.Removed {
+			fmt.Fprintf(&b, "    - %q\n", value)
+		}
+	}
+	if b.Len() == 0 {
+		return "no changes\n"
+	}
+	return b.String()
+}
+
+func entityKey(entityType, subType, category string) string {
+	return entityType + "/" + subType + "/" + category
+}
@@ -58,16 +58,16 @@ type CreateEntityRequest struct {
 	EntityArray []EntityRequest
 }
 
-// TrackerTupleRequest to modify a tracker
+// TrackerTupleRequest is a JSON-Patch (RFC 6902) operation to modify a tracker
 type TrackerTupleRequest struct {
-	Op    string `json:"op" validate:"required"`
-	Path  string `json:"path" validate:"required"`
-	Value string `json:"value" validate:"required"`
+	Op    string      `json:"op" validate:"required"`
+	Path  string      `json:"path" validate:"required"`
+	Value interface{} `json:"value,omitempty"`
 }
 
 // UpdateTrackerRequest container for TrackerTupleRequest requests
 type UpdateTrackerRequest struct {
-	TrackerArray []TrackerTupleRequest
+	TrackerArray []TrackerTupleRequest `validate:"dive"`
 }
 
 /*
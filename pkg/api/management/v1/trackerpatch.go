@@ -0,0 +1,181 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"context"
+	"strconv"
+
+	klog "k8s.io/klog/v2"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// NewTrackerPatch starts a patch against the current state of a tracker. Each operation is
+// checked against the tracker as modified by the operations before it, so removals resolve
+// to the right array index. The first failed check is returned by Build.
+func NewTrackerPatch(current *mgmtinterfaces.Tracker) *TrackerPatch {
+	p := &TrackerPatch{
+		ops: make([]mgmtinterfaces.TrackerTupleRequest, 0),
+	}
+	if current == nil {
+		klog.V(1).Infof("NewTrackerPatch tracker is nil\n")
+		p.err = ErrInvalidInput
+		return p
+	}
+
+	p.trackerId = current.ID
+	p.tracker = *current
+	p.tracker.Categories = append([]string{}, current.Categories...)
+	p.tracker.Languages = append([]string{}, current.Languages...)
+	p.tracker.Vocabulary = append([]string{}, current.Vocabulary...)
+	return p
+}
+
+// AddVocabulary appends phrases to the vocabulary
+func (p *TrackerPatch) AddVocabulary(phrases ...string) *TrackerPatch {
+	for _, phrase := range phrases {
+		if p.err != nil {
+			return p
+		}
+		if phrase == "" {
+			klog.V(1).Infof("AddVocabulary phrase is empty\n")
+			p.err = ErrInvalidInput
+			return p
+		}
+		if indexOf(p.tracker.Vocabulary, phrase) >= 0 {
+			klog.V(1).Infof("AddVocabulary %q already exists\n", phrase)
+			p.err = ErrVocabularyExists
+			return p
+		}
+
+		p.tracker.Vocabulary = append(p.tracker.Vocabulary, phrase)
+		p.ops = append(p.ops, mgmtinterfaces.TrackerTupleRequest{
+			Op:    mgmtinterfaces.TrackerOperationAdd,
+			Path:  mgmtinterfaces.TrackerPathVocabulary + "/-",
+			Value: phrase,
+		})
+	}
+	return p
+}
+
+// RemoveVocabulary removes phrases from the vocabulary
+func (p *TrackerPatch) RemoveVocabulary(phrases ...string) *TrackerPatch {
+	for _, phrase := range phrases {
+		if p.err != nil {
+			return p
+		}
+		i := indexOf(p.tracker.Vocabulary, phrase)
+		if i < 0 {
+			klog.V(1).Infof("RemoveVocabulary %q not found\n", phrase)
+			p.err = ErrVocabularyNotFound
+			return p
+		}
+
+		p.tracker.Vocabulary = append(p.tracker.Vocabulary[:i], p.tracker.Vocabulary[i+1:]...)
+		p.ops = append(p.ops, mgmtinterfaces.TrackerTupleRequest{
+			Op:   mgmtinterfaces.TrackerOperationRemove,
+			Path: mgmtinterfaces.TrackerPathVocabulary + "/" + strconv.Itoa(i),
+		})
+	}
+	return p
+}
+
+// ReplaceDescription sets the description
+func (p *TrackerPatch) ReplaceDescription(description string) *TrackerPatch {
+	if p.err != nil {
+		return p
+	}
+
+	// the member is omitted when empty and "replace" requires it to exist
+	op := mgmtinterfaces.TrackerOperationReplace
+	if p.tracker.Description == "" {
+		op = mgmtinterfaces.TrackerOperationAdd
+	}
+
+	p.tracker.Description = description
+	p.ops = append(p.ops, mgmtinterfaces.TrackerTupleRequest{
+		Op:    op,
+		Path:  mgmtinterfaces.TrackerPathDescription,
+		Value: description,
+	})
+	return p
+}
+
+// SetCategories replaces the categories. Without categories, the categories are cleared.
+func (p *TrackerPatch) SetCategories(categories ...string) *TrackerPatch {
+	if p.err != nil {
+		return p
+	}
+
+	if op, ok := listOp(mgmtinterfaces.TrackerPathCategories, p.tracker.Categories, categories); ok {
+		p.ops = append(p.ops, op)
+	}
+	p.tracker.Categories = append([]string{}, categories...)
+	return p
+}
+
+// SetLanguages replaces the languages. Without languages, the languages are cleared.
+func (p *TrackerPatch) SetLanguages(languages ...string) *TrackerPatch {
+	if p.err != nil {
+		return p
+	}
+
+	if op, ok := listOp(mgmtinterfaces.TrackerPathLanguages, p.tracker.Languages, languages); ok {
+		p.ops = append(p.ops, op)
+	}
+	p.tracker.Languages = append([]string{}, languages...)
+	return p
+}
+
+// Tracker returns the tracker as it will look once the patch is applied
+func (p *TrackerPatch) Tracker() mgmtinterfaces.Tracker {
+	return p.tracker
+}
+
+// Build returns the request for UpdateTracker or the first validation error
+func (p *TrackerPatch) Build() (*mgmtinterfaces.UpdateTrackerRequest, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &mgmtinterfaces.UpdateTrackerRequest{
+		TrackerArray: append([]mgmtinterfaces.TrackerTupleRequest{}, p.ops...),
+	}, nil
+}
+
+// PatchTracker builds the patch and sends it with UpdateTracker
+func (m *Management) PatchTracker(ctx context.Context, patch *TrackerPatch) (*mgmtinterfaces.TrackerResponse, error) {
+	klog.V(6).Infof("mgmt.PatchTracker ENTER\n")
+
+	// validate input
+	if patch == nil {
+		klog.V(1).Infof("patch is nil\n")
+		klog.V(6).Infof("mgmt.PatchTracker LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if patch.trackerId == "" {
+		klog.V(1).Infof("trackerId is empty\n")
+		klog.V(6).Infof("mgmt.PatchTracker LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	request, err := patch.Build()
+	if err != nil {
+		klog.V(1).Infof("Build failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PatchTracker LEAVE\n")
+		return nil, err
+	}
+
+	result, err := m.UpdateTracker(ctx, patch.trackerId, *request)
+	if err != nil {
+		klog.V(1).Infof("UpdateTracker failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PatchTracker LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("PatchTracker succeeded\n")
+	klog.V(6).Infof("mgmt.PatchTracker LEAVE\n")
+	return result, nil
+}
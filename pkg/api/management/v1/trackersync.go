@@ -0,0 +1,277 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// LoadTrackers reads tracker definitions from YAML or JSON. The document is either a list of
+// trackers or an object with a "trackers" list.
+func LoadTrackers(r io.Reader) ([]mgmtinterfaces.TrackerRequest, error) {
+	klog.V(6).Infof("mgmt.LoadTrackers ENTER\n")
+
+	var trackers []mgmtinterfaces.TrackerRequest
+	err := decodeDefinitions(r, "trackers", &trackers)
+	if err != nil {
+		klog.V(1).Infof("decodeDefinitions failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.LoadTrackers LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("LoadTrackers found %d trackers\n", len(trackers))
+	klog.V(6).Infof("mgmt.LoadTrackers LEAVE\n")
+	return trackers, nil
+}
+
+// DiffTrackers computes the changes to turn the current trackers into the desired ones. Trackers
+// are matched by name.
+func DiffTrackers(current []mgmtinterfaces.Tracker, desired []mgmtinterfaces.TrackerRequest, prune bool) (*TrackerPlan, error) {
+	existing := make(map[string]mgmtinterfaces.Tracker, len(current))
+	for _, tracker := range current {
+		existing[tracker.Name] = tracker
+	}
+
+	plan := &TrackerPlan{}
+	seen := make(map[string]bool, len(desired))
+
+	for _, want := range desired {
+		if want.Name == "" {
+			klog.V(1).Infof("Tracker name is empty\n")
+			return nil, ErrInvalidInput
+		}
+		if seen[want.Name] {
+			klog.V(1).Infof("Tracker %s is defined more than once\n", want.Name)
+			return nil, ErrDuplicateDefinition
+		}
+		seen[want.Name] = true
+
+		if len(want.Languages) == 0 {
+			want.Languages = []string{mgmtinterfaces.TrackerLanguageDefault}
+		}
+
+		have, ok := existing[want.Name]
+		if !ok {
+			request := want
+			plan.Changes = append(plan.Changes, TrackerChange{
+				Action: PlanActionCreate,
+				Name:   want.Name,
+				Create: &request,
+			})
+			continue
+		}
+
+		patch := trackerPatch(have, want)
+		update, err := patch.Build()
+		if err != nil {
+			klog.V(1).Infof("Tracker %s patch failed. Err: %v\n", want.Name, err)
+			return nil, err
+		}
+
+		if len(update.TrackerArray) > 0 {
+			added, removed := diffSets(have.Vocabulary, want.Vocabulary)
+			plan.Changes = append(plan.Changes, TrackerChange{
+				Action:    PlanActionUpdate,
+				Name:      want.Name,
+				TrackerID: have.ID,
+				Update:    update,
+				Added:     added,
+				Removed:   removed,
+			})
+		}
+	}
+
+	if prune {
+		for _, tracker := range current {
+			if seen[tracker.Name] {
+				continue
+			}
+			plan.Changes = append(plan.Changes, TrackerChange{
+				Action:    PlanActionDelete,
+				Name:      tracker.Name,
+				TrackerID: tracker.ID,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// PlanTrackers diffs the desired trackers against the trackers on the platform
+func (m *Management) PlanTrackers(ctx context.Context, desired []mgmtinterfaces.TrackerRequest, opts SyncOpts) (*TrackerPlan, error) {
+	klog.V(6).Infof("mgmt.PlanTrackers ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	current, err := m.GetTrackers(ctx)
+	if err != nil {
+		klog.V(1).Infof("GetTrackers failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PlanTrackers LEAVE\n")
+		return nil, err
+	}
+
+	plan, err := DiffTrackers(current.Trackers, desired, opts.Prune)
+	if err != nil {
+		klog.V(1).Infof("DiffTrackers failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.PlanTrackers LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(3).Infof("PlanTrackers found %d changes\n", len(plan.Changes))
+	klog.V(6).Infof("mgmt.PlanTrackers LEAVE\n")
+	return plan, nil
+}
+
+// ApplyTrackerPlan applies the changes in order and stops at the first failure. The outcome of
+// each change is recorded in the plan.
+func (m *Management) ApplyTrackerPlan(ctx context.Context, plan *TrackerPlan) error {
+	klog.V(6).Infof("mgmt.ApplyTrackerPlan ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if plan == nil {
+		klog.V(1).Infof("plan is nil\n")
+		klog.V(6).Infof("mgmt.ApplyTrackerPlan LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	for i := range plan.Changes {
+		change := &plan.Changes[i]
+
+		var err error
+		switch change.Action {
+		case PlanActionCreate:
+			var result *mgmtinterfaces.TrackerResponse
+			result, err = m.CreateTracker(ctx, *change.Create)
+			if err == nil {
+				change.TrackerID = result.Tracker.ID
+			}
+		case PlanActionUpdate:
+			_, err = m.UpdateTracker(ctx, change.TrackerID, *change.Update)
+		case PlanActionDelete:
+			err = m.DeleteTracker(ctx, change.TrackerID)
+		default:
+			err = ErrInvalidInput
+		}
+
+		if err != nil {
+			klog.V(1).Infof("Failed to %s tracker %s. Err: %v\n", change.Action, change.Name, err)
+			klog.V(6).Infof("mgmt.ApplyTrackerPlan LEAVE\n")
+			change.Err = err
+			return err
+		}
+		change.Applied = true
+		klog.V(3).Infof("Applied %s tracker %s\n", change.Action, change.Name)
+	}
+
+	klog.V(6).Infof("mgmt.ApplyTrackerPlan LEAVE\n")
+	return nil
+}
+
+// SyncTrackers reconciles the trackers on the platform with the desired trackers. With DryRun
+// the plan is returned without being applied.
+func (m *Management) SyncTrackers(ctx context.Context, desired []mgmtinterfaces.TrackerRequest, opts SyncOpts) (*TrackerPlan, error) {
+	klog.V(6).Infof("mgmt.SyncTrackers ENTER\n")
+
+	plan, err := m.PlanTrackers(ctx, desired, opts)
+	if err != nil {
+		klog.V(1).Infof("PlanTrackers failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.SyncTrackers LEAVE\n")
+		return nil, err
+	}
+
+	if opts.DryRun {
+		klog.V(3).Infof("DryRun plan:\n%s", plan)
+		klog.V(6).Infof("mgmt.SyncTrackers LEAVE\n")
+		return plan, nil
+	}
+
+	err = m.ApplyTrackerPlan(ctx, plan)
+	if err != nil {
+		klog.V(1).Infof("ApplyTrackerPlan failed. Err: %v\n", err)
+		klog.V(6).Infof("mgmt.SyncTrackers LEAVE\n")
+		return plan, err
+	}
+
+	klog.V(6).Infof("mgmt.SyncTrackers LEAVE\n")
+	return plan, nil
+}
+
+// String renders the plan for dry-run output
+func (p *TrackerPlan) String() string {
+	var b strings.Builder
+	for _, change := range p.Changes {
+		switch change.Action {
+		case PlanActionCreate:
+			fmt.Fprintf(&b, "+ create tracker %q\n", change.Name)
+			for _, phrase := range change.Create.Vocabulary {
+				fmt.Fprintf(&b, "    + %s %q\n", mgmtinterfaces.TrackerPathVocabulary, phrase)
+			}
+		case PlanActionUpdate:
+			fmt.Fprintf(&b, "~ update tracker %q (%s)\n", change.Name, change.TrackerID)
+			removed := 0
+			for _, tuple := range change.Update.TrackerArray {
+				// removes are by index so show the phrase being removed
+				if tuple.Op == mgmtinterfaces.TrackerOperationRemove &&
+					strings.HasPrefix(tuple.Path, mgmtinterfaces.TrackerPathVocabulary+"/") &&
+					removed < len(change.Removed) {
+					fmt.Fprintf(&b, "    %s %s %q\n", opSymbol(tuple.Op), tuple.Path, change.Removed[removed])
+					removed++
+					continue
+				}
+				if tuple.Value == nil {
+					fmt.Fprintf(&b, "    %s %s\n", opSymbol(tuple.Op), tuple.Path)
+					continue
+				}
+				fmt.Fprintf(&b, "    %s %s %q\n", opSymbol(tuple.Op), tuple.Path, tuple.Value)
+			}
+		case PlanActionDelete:
+			fmt.Fprintf(&b, "- delete tracker %q (%s)\n", change.Name, change.TrackerID)
+		}
+	}
+	if b.Len() == 0 {
+		return "no changes\n"
+	}
+	return b.String()
+}
+
+// trackerPatch builds the patch which turns the tracker into the desired one
+func trackerPatch(have mgmtinterfaces.Tracker, want mgmtinterfaces.TrackerRequest) *TrackerPatch {
+	patch := NewTrackerPatch(&have)
+	if want.Description != have.Description {
+		patch.ReplaceDescription(want.Description)
+	}
+	if !sameSet(have.Categories, want.Categories) {
+		patch.SetCategories(want.Categories...)
+	}
+	if !sameSet(have.Languages, want.Languages) {
+		patch.SetLanguages(want.Languages...)
+	}
+	added, removed := diffSets(have.Vocabulary, want.Vocabulary)
+	return patch.RemoveVocabulary(removed...).AddVocabulary(added...)
+}
+
+func opSymbol(op string) string {
+	switch op {
+	case mgmtinterfaces.TrackerOperationAdd:
+		return "+"
+	case mgmtinterfaces.TrackerOperationRemove:
+		return "-"
+	default:
+		return "~"
+	}
+}
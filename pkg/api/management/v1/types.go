@@ -0,0 +1,124 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"time"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// SyncOpts controls reconciling desired definitions with the platform
+type SyncOpts struct {
+	// Prune deletes definitions on the platform which are not in the desired list
+	Prune bool
+
+	// DryRun only computes the plan
+	DryRun bool
+}
+
+// TrackerChange is a single step of a TrackerPlan
+type TrackerChange struct {
+	Action    string
+	Name      string
+	TrackerID string
+
+	// Create is set for PlanActionCreate
+	Create *mgmtinterfaces.TrackerRequest
+
+	// Update is set for PlanActionUpdate
+	Update *mgmtinterfaces.UpdateTrackerRequest
+
+	// Added and Removed are the vocabulary changes for PlanActionUpdate
+	Added   []string
+	Removed []string
+
+	// Applied and Err report the outcome when the plan is applied
+	Applied bool
+	Err     error
+}
+
+// TrackerPlan is the list of changes needed to reconcile the trackers
+type TrackerPlan struct {
+	Changes []TrackerChange
+}
+
+// TrackerPatch builds a validated JSON-Patch for UpdateTracker. See NewTrackerPatch.
+type TrackerPatch struct {
+	trackerId string
+	tracker   mgmtinterfaces.Tracker
+	ops       []mgmtinterfaces.TrackerTupleRequest
+	err       error
+}
+
+// Criterion is a node of conversation group criteria built with MetadataEquals, StartTimeAfter,
+// And, Or and the related functions
+type Criterion struct {
+	operator string
+	field    string
+	value    string
+	children []Criterion
+	err      error
+}
+
+// EntityChange is a single step of an EntityPlan
+type EntityChange struct {
+	Action   string
+	Key      string
+	EntityID string
+
+	// Create is set for PlanActionCreate
+	Create *mgmtinterfaces.EntityRequest
+
+	// Update is set for PlanActionUpdate
+	Update *mgmtinterfaces.Entity
+
+	// Added and Removed are the value changes for PlanActionUpdate
+	Added   []string
+	Removed []string
+
+	// Applied and Err report the outcome when the plan is applied
+	Applied bool
+	Err     error
+}
+
+// EntityPlan is the list of changes needed to reconcile the custom entities
+type EntityPlan struct {
+	Changes []EntityChange
+}
+
+// AccountConfig is a snapshot of the trackers, custom entities and conversation groups of an account
+type AccountConfig struct {
+	Version    int                      `json:"version"`
+	ExportedAt time.Time                `json:"exportedAt"`
+	Trackers   []mgmtinterfaces.Tracker `json:"trackers"`
+	Entities   []mgmtinterfaces.Entity  `json:"entities"`
+	Groups     []mgmtinterfaces.Group   `json:"groups"`
+}
+
+// ImportConfigOpts controls how an AccountConfig is restored
+type ImportConfigOpts struct {
+	// Conflict is one of the Conflict constants and applies when a tracker or group with the same
+	// name, or an entity with the same type, subType and category, already exists. Defaults to ConflictSkip.
+	Conflict string
+
+	// RenameSuffix is appended to the name or entity subType with ConflictRename. Defaults to "-imported".
+	RenameSuffix string
+}
+
+// ImportConfigResult maps the IDs in the AccountConfig to the IDs in the account
+type ImportConfigResult struct {
+	TrackerIDs map[string]string
+	EntityIDs  map[string]string
+	GroupIDs   map[string]string
+
+	// Created, Updated and Skipped count definitions by outcome
+	Created int
+	Updated int
+	Skipped int
+
+	// Errors are failures restoring individual definitions
+	Errors []error
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package management
+
+import (
+	"encoding/json"
+	"io"
+	"sort"
+
+	yaml "gopkg.in/yaml.v3"
+
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// decodeDefinitions decodes a YAML or JSON document which is either a list or an object holding
+// the list under key. YAML is converted to JSON first so the json struct tags apply.
+func decodeDefinitions(r io.Reader, key string, out interface{}) error {
+	var doc interface{}
+	err := yaml.NewDecoder(r).Decode(&doc)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if obj, ok := doc.(map[string]interface{}); ok {
+		doc = obj[key]
+	}
+	if doc == nil {
+		doc = []interface{}{}
+	}
+
+	byDoc, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(byDoc, out)
+}
+
+// diffSets returns the sorted values only in want and only in have
+func diffSets(have, want []string) ([]string, []string) {
+	haveSet := make(map[string]bool, len(have))
+	for _, v := range have {
+		haveSet[v] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, v := range want {
+		wantSet[v] = true
+	}
+
+	added := make([]string, 0)
+	for _, v := range sortedKeys(wantSet) {
+		if !haveSet[v] {
+			added = append(added, v)
+		}
+	}
+	removed := make([]string, 0)
+	for _, v := range sortedKeys(haveSet) {
+		if !wantSet[v] {
+			removed = append(removed, v)
+		}
+	}
+	return added, removed
+}
+
+// sameSet reports whether both lists hold the same values regardless of order
+func sameSet(a, b []string) bool {
+	added, removed := diffSets(a, b)
+	return len(added) == 0 && len(removed) == 0
+}
+
+// listOp builds the operation which sets a list member. The member is omitted when empty so
+// "replace" requires it to exist and clearing it is a "remove".
+func listOp(path string, current, values []string) (mgmtinterfaces.TrackerTupleRequest, bool) {
+	switch {
+	case len(values) == 0 && len(current) == 0:
+		return mgmtinterfaces.TrackerTupleRequest{}, false
+	case len(values) == 0:
+		return mgmtinterfaces.TrackerTupleRequest{
+			Op:   mgmtinterfaces.TrackerOperationRemove,
+			Path: path,
+		}, true
+	case len(current) == 0:
+		return mgmtinterfaces.TrackerTupleRequest{
+			Op:    mgmtinterfaces.TrackerOperationAdd,
+			Path:  path,
+			Value: append([]string{}, values...),
+		}, true
+	}
+
+	return mgmtinterfaces.TrackerTupleRequest{
+		Op:    mgmtinterfaces.TrackerOperationReplace,
+		Path:  path,
+		Value: append([]string{}, values...),
+	}, true
+}
+
+func indexOf(values []string, value string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,18 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package microphone
+
+import (
+	"errors"
+)
+
+const (
+	defaultBufferSize int = 1024
+)
+
+var (
+	// ErrNotSupported the audio device is not available in this build
+	ErrNotSupported = errors.New("audio device capture is not supported in this build. rebuild with cgo and portaudio or provide AudioConfig.Reader")
+)
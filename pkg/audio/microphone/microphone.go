@@ -9,43 +9,43 @@ import (
 	"encoding/binary"
 	"io"
 
-	"github.com/gordonklaus/portaudio"
 	klog "k8s.io/klog/v2"
 )
 
 // Initialize inits the library
 func Initialize() {
-	portaudio.Initialize()
+	initialize()
 }
 
 // Teardown cleans up the library
 func Teardown() {
-	portaudio.Terminate()
+	terminate()
 }
 
-// New creates a new microphone using portaudio
+// New creates a new microphone using portaudio or, when AudioConfig.Reader is set, a raw PCM reader
 func New(cfg AudioConfig) (*Microphone, error) {
 	klog.V(6).Infof("Microphone.New ENTER\n")
 
 	m := &Microphone{
 		stopChan: make(chan struct{}),
-		intBuf:   make([]int16, 1024),
+		intBuf:   make([]int16, defaultBufferSize),
 		muted:    false,
 	}
 
-	portaudio.Initialize()
-
-	stream, err := portaudio.OpenDefaultStream(cfg.InputChannels, 0, float64(cfg.SamplingRate), len(m.intBuf), m.intBuf)
-	if err != nil {
-		klog.V(1).Infof("OpenDefaultStream failed. Err: %v\n", err)
-		klog.V(6).Infof("Microphone.New LEAVE\n")
-		return nil, err
+	if cfg.Reader != nil {
+		klog.V(4).Infof("Using reader as the audio source\n")
+		m.source = newPipeSource(cfg.Reader, len(m.intBuf))
+	} else {
+		source, err := newDeviceSource(cfg, len(m.intBuf))
+		if err != nil {
+			klog.V(1).Infof("newDeviceSource failed. Err: %v\n", err)
+			klog.V(6).Infof("Microphone.New LEAVE\n")
+			return nil, err
+		}
+		m.source = source
 	}
 
-	// housekeeping
-	m.stream = stream
-
-	klog.V(3).Infof("OpenDefaultStream succeded\n")
+	klog.V(3).Infof("Microphone.New succeded\n")
 	klog.V(6).Infof("Microphone.New LEAVE\n")
 
 	return m, nil
@@ -53,7 +53,7 @@ func New(cfg AudioConfig) (*Microphone, error) {
 
 // Start begins the listening on the microphone
 func (m *Microphone) Start() error {
-	err := m.stream.Start()
+	err := m.source.Start()
 	if err != nil {
 		klog.V(1).Infof("Mic failed to start. Err: %v\n", err)
 		return err
@@ -65,13 +65,13 @@ func (m *Microphone) Start() error {
 
 // Read gets the raw bits generated by the mic
 func (m *Microphone) Read() ([]int16, error) {
-	err := m.stream.Read()
+	err := m.source.Read(m.intBuf)
 	if err != nil {
-		klog.V(1).Infof("stream.Read failed. Err: %v\n", err)
+		klog.V(1).Infof("source.Read failed. Err: %v\n", err)
 		return nil, err
 	}
 
-	buf := make([]int16, 1024)
+	buf := make([]int16, len(m.intBuf))
 	byteCopied := copy(buf, m.intBuf)
 	klog.V(7).Infof("stream.Read bytes copied: %d\n", byteCopied)
 	return buf, nil
@@ -84,9 +84,13 @@ func (m *Microphone) Stream(w io.Writer) error {
 		case <-m.stopChan:
 			return nil
 		default:
-			err := m.stream.Read()
+			err := m.source.Read(m.intBuf)
+			if err == io.EOF {
+				klog.V(6).Infof("source.Read EOF\n")
+				return nil
+			}
 			if err != nil {
-				klog.V(1).Infof("stream.Read failed. Err: %v\n", err)
+				klog.V(1).Infof("source.Read failed. Err: %v\n", err)
 				return err
 			}
 
@@ -118,9 +122,9 @@ func (m *Microphone) Unmute() {
 
 // Stop terminates listening on the mic
 func (m *Microphone) Stop() error {
-	err := m.stream.Stop()
+	err := m.source.Stop()
 	if err != nil {
-		klog.V(1).Infof("stream.Stop failed. Err: %v\n", err)
+		klog.V(1).Infof("source.Stop failed. Err: %v\n", err)
 		return err
 	}
 
@@ -0,0 +1,57 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package microphone
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// pipeSource captures raw 16-bit little-endian PCM audio from a reader
+type pipeSource struct {
+	reader io.Reader
+	byBuf  []byte
+	eof    bool
+}
+
+func newPipeSource(r io.Reader, bufSize int) captureSource {
+	return &pipeSource{
+		reader: r,
+		byBuf:  make([]byte, bufSize*2),
+	}
+}
+
+func (s *pipeSource) Start() error {
+	return nil
+}
+
+func (s *pipeSource) Read(buf []int16) error {
+	if s.eof {
+		return io.EOF
+	}
+
+	n, err := io.ReadFull(s.reader, s.byBuf)
+	if err == io.ErrUnexpectedEOF {
+		// emit the partial frame padded with silence and report EOF on the next read
+		for i := n; i < len(s.byBuf); i++ {
+			s.byBuf[i] = 0
+		}
+		s.eof = true
+	} else if err != nil {
+		return err
+	}
+
+	for i := range buf {
+		buf[i] = int16(binary.LittleEndian.Uint16(s.byBuf[i*2:]))
+	}
+	return nil
+}
+
+func (s *pipeSource) Stop() error {
+	if closer, ok := s.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build cgo && !noportaudio
+// +build cgo,!noportaudio
+
+package microphone
+
+import (
+	"github.com/gordonklaus/portaudio"
+	klog "k8s.io/klog/v2"
+)
+
+// portaudioSource captures audio from the default input device using portaudio
+type portaudioSource struct {
+	stream *portaudio.Stream
+	intBuf []int16
+}
+
+func initialize() {
+	portaudio.Initialize()
+}
+
+func terminate() {
+	portaudio.Terminate()
+}
+
+func newDeviceSource(cfg AudioConfig, bufSize int) (captureSource, error) {
+	s := &portaudioSource{
+		intBuf: make([]int16, bufSize),
+	}
+
+	portaudio.Initialize()
+
+	stream, err := portaudio.OpenDefaultStream(cfg.InputChannels, 0, float64(cfg.SamplingRate), len(s.intBuf), s.intBuf)
+	if err != nil {
+		klog.V(1).Infof("OpenDefaultStream failed. Err: %v\n", err)
+		return nil, err
+	}
+
+	// housekeeping
+	s.stream = stream
+
+	klog.V(3).Infof("OpenDefaultStream succeded\n")
+
+	return s, nil
+}
+
+func (s *portaudioSource) Start() error {
+	return s.stream.Start()
+}
+
+func (s *portaudioSource) Read(buf []int16) error {
+	err := s.stream.Read()
+	if err != nil {
+		return err
+	}
+
+	copy(buf, s.intBuf)
+	return nil
+}
+
+func (s *portaudioSource) Stop() error {
+	return s.stream.Stop()
+}
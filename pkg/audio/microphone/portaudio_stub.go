@@ -0,0 +1,21 @@
+// Copyright 2022 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build !cgo || noportaudio
+// +build !cgo noportaudio
+
+package microphone
+
+import (
+	klog "k8s.io/klog/v2"
+)
+
+func initialize() {}
+
+func terminate() {}
+
+func newDeviceSource(cfg AudioConfig, bufSize int) (captureSource, error) {
+	klog.V(1).Infof("portaudio is not available in this build\n")
+	return nil, ErrNotSupported
+}
@@ -5,21 +5,32 @@
 package microphone
 
 import (
+	"io"
 	"sync"
-
-	"github.com/gordonklaus/portaudio"
 )
 
 // AudioConfig init config for library
 type AudioConfig struct {
 	InputChannels int
 	SamplingRate  float32
+
+	// Reader is an optional source of raw 16-bit little-endian PCM audio (ie os.Stdin or a
+	// named pipe fed by arecord). When set, the audio device is not used and cgo is not required.
+	// The Reader is closed on Stop if it implements io.Closer.
+	Reader io.Reader
+}
+
+// captureSource is a backend which fills a buffer with captured audio
+type captureSource interface {
+	Start() error
+	Read(buf []int16) error
+	Stop() error
 }
 
 // Microphone...
 type Microphone struct {
 	// microphone
-	stream *portaudio.Stream
+	source captureSource
 
 	// buffer
 	intBuf []int16
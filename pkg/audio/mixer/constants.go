@@ -0,0 +1,32 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Implementation for an audio mixer which combines microphone, replay and text-to-speech sources
+*/
+package mixer
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	defaultFrameDuration time.Duration = 20 * time.Millisecond
+	defaultGain          float64       = 1.0
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrInputNotFound the named input was not added to the mixer
+	ErrInputNotFound = errors.New("input not found")
+
+	// ErrUnsupportedEncoding the audio encoding is not supported
+	ErrUnsupportedEncoding = errors.New("unsupported audio encoding")
+
+	// ErrAlreadyStarted inputs can't be added once the mixer has started
+	ErrAlreadyStarted = errors.New("mixer has already been started")
+)
@@ -0,0 +1,27 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Defines an implementation to mix multiple audio sources
+*/
+package interfaces
+
+import (
+	ttsinterfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
+)
+
+// Encoding of raw audio. It is the text-to-speech AudioEncoding so the encoding of a synthesizer
+// can be passed to the mixer as is. Unspecified is treated as Linear16.
+type Encoding = ttsinterfaces.AudioEncoding
+
+const (
+	EncodingUnspecified = ttsinterfaces.AudioEncodingUnspecified
+	EncodingLinear16    = ttsinterfaces.AudioEncodingLinear16
+	EncodingMulaw       = ttsinterfaces.AudioEncodingMulaw
+	EncodingAlaw        = ttsinterfaces.AudioEncodingAlaw
+)
+
+var (
+	DefaultSampleRateHertz int32 = 8000
+)
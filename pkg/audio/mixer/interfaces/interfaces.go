@@ -0,0 +1,25 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Defines an implementation to mix multiple audio sources
+*/
+package interfaces
+
+import "io"
+
+// Source is anything which produces raw audio such as the replay or text-to-speech clients
+type Source interface {
+	Read() ([]byte, error)
+}
+
+// Mixer combines multiple audio sources into a single stream
+type Mixer interface {
+	Start() error
+	Read() ([]byte, error)
+	Stream(w io.Writer) error
+	Mute()
+	Unmute()
+	Stop() error
+}
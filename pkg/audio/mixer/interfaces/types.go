@@ -0,0 +1,15 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Defines an implementation to mix multiple audio sources
+*/
+package interfaces
+
+// Format describes raw audio
+type Format struct {
+	Encoding        Encoding
+	SampleRateHertz int32
+	Channels        int
+}
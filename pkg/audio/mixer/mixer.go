@@ -0,0 +1,279 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Implementation for an audio mixer which combines microphone, replay and text-to-speech sources
+*/
+package mixer
+
+import (
+	"io"
+	"math"
+
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/mixer/interfaces"
+)
+
+// New creates an audio mixer
+func New(opts MixerOpts) (*Client, error) {
+	klog.V(6).Infof("Mixer.New ENTER\n")
+
+	if opts.Format.SampleRateHertz == 0 {
+		opts.Format.SampleRateHertz = interfaces.DefaultSampleRateHertz
+	}
+	if opts.FrameDuration <= 0 {
+		opts.FrameDuration = defaultFrameDuration
+	}
+	if opts.Format.Encoding == interfaces.EncodingUnspecified {
+		opts.Format.Encoding = interfaces.EncodingLinear16
+	}
+	opts.Format.Channels = 1
+
+	if !isSupported(opts.Format.Encoding) {
+		klog.V(1).Infof("Encoding %d is not supported\n", opts.Format.Encoding)
+		klog.V(6).Infof("Mixer.New LEAVE\n")
+		return nil, ErrUnsupportedEncoding
+	}
+
+	client := &Client{
+		options:  opts,
+		stopChan: make(chan struct{}),
+		muted:    false,
+	}
+
+	klog.V(3).Infof("Mixer.New Succeeded\n")
+	klog.V(6).Infof("Mixer.New LEAVE\n")
+
+	return client, nil
+}
+
+// AddInput adds an audio source to the mix
+func (c *Client) AddInput(in Input) error {
+	klog.V(6).Infof("Mixer.AddInput ENTER\n")
+
+	if in.Source == nil || in.Format.SampleRateHertz <= 0 {
+		klog.V(1).Infof("Source and Format.SampleRateHertz are required\n")
+		klog.V(6).Infof("Mixer.AddInput LEAVE\n")
+		return ErrInvalidInput
+	}
+	if in.Format.Encoding == interfaces.EncodingUnspecified {
+		in.Format.Encoding = interfaces.EncodingLinear16
+	}
+	if !isSupported(in.Format.Encoding) {
+		klog.V(1).Infof("Encoding %d is not supported\n", in.Format.Encoding)
+		klog.V(6).Infof("Mixer.AddInput LEAVE\n")
+		return ErrUnsupportedEncoding
+	}
+	if in.Format.Channels <= 0 {
+		in.Format.Channels = 1
+	}
+	if in.Gain == 0 {
+		in.Gain = defaultGain
+	}
+
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.started {
+		klog.V(1).Infof("Mixer already started\n")
+		klog.V(6).Infof("Mixer.AddInput LEAVE\n")
+		return ErrAlreadyStarted
+	}
+
+	c.inputs = append(c.inputs, &input{Input: in})
+
+	klog.V(4).Infof("Input %s added\n", in.Name)
+	klog.V(6).Infof("Mixer.AddInput LEAVE\n")
+	return nil
+}
+
+// SetGain changes the gain of a named input while mixing
+func (c *Client) SetGain(name string, gain float64) error {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	for _, in := range c.inputs {
+		if in.Name == name {
+			in.Gain = gain
+			return nil
+		}
+	}
+
+	klog.V(1).Infof("Input %s not found\n", name)
+	return ErrInputNotFound
+}
+
+// Start begins mixing the inputs
+func (c *Client) Start() error {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if len(c.inputs) == 0 {
+		klog.V(1).Infof("Mixer has no inputs\n")
+		return ErrInvalidInput
+	}
+
+	c.started = true
+	return nil
+}
+
+// Read mixes the next frame of audio from all inputs. Inputs which have finished are
+// treated as silence and io.EOF is returned once every input has finished.
+func (c *Client) Read() ([]byte, error) {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	frameSize := int(c.options.FrameDuration.Seconds() * float64(c.options.Format.SampleRateHertz))
+	mix := make([]float64, frameSize)
+
+	produced := 0
+	for _, in := range c.inputs {
+		if in.eof && len(in.pending) == 0 {
+			continue
+		}
+
+		samples, err := c.resample(in, frameSize)
+		if err != nil {
+			klog.V(1).Infof("Input %s failed. Err: %v\n", in.Name, err)
+			return []byte{}, err
+		}
+
+		for i, v := range samples {
+			mix[i] += v * in.Gain
+		}
+		if len(samples) > produced {
+			produced = len(samples)
+		}
+	}
+
+	if produced == 0 {
+		return []byte{}, io.EOF
+	}
+
+	// clipping protection
+	pcm := make([]int16, produced)
+	for i := range pcm {
+		v := math.Round(mix[i])
+		if v > math.MaxInt16 {
+			v = math.MaxInt16
+		} else if v < math.MinInt16 {
+			v = math.MinInt16
+		}
+		pcm[i] = int16(v)
+	}
+
+	klog.V(7).Infof("Mixer.Read samples mixed: %d\n", produced)
+
+	return encode(pcm, c.options.Format.Encoding), nil
+}
+
+// Stream is a helper function to stream the mixed audio to a source
+func (c *Client) Stream(w io.Writer) error {
+	for {
+		select {
+		case <-c.stopChan:
+			klog.V(6).Infof("stopChan signal exit\n")
+			return nil
+		default:
+			byData, err := c.Read()
+			if err == io.EOF {
+				klog.V(6).Infof("Mixer.Read EOF\n")
+				return nil
+			}
+			if err != nil {
+				klog.V(1).Infof("Mixer.Read failed. Err: %v\n", err)
+				return err
+			}
+
+			c.mute.Lock()
+			isMuted := c.muted
+			c.mute.Unlock()
+
+			if isMuted {
+				klog.V(7).Infof("Mixer is MUTED!\n")
+				byData = encode(make([]int16, len(byData)/bytesPerSample(c.options.Format.Encoding)), c.options.Format.Encoding)
+			}
+
+			byteCount, err := w.Write(byData)
+			if err != nil {
+				klog.V(1).Infof("w.Write failed. Err: %v\n", err)
+				return err
+			}
+			klog.V(7).Infof("io.Writer succeeded. Bytes written: %d\n", byteCount)
+		}
+	}
+}
+
+// Mute silences the mixed output
+func (c *Client) Mute() {
+	c.mute.Lock()
+	c.muted = true
+	c.mute.Unlock()
+}
+
+// Unmute restores the mixed output
+func (c *Client) Unmute() {
+	c.mute.Lock()
+	c.muted = false
+	c.mute.Unlock()
+}
+
+// Stop terminates the mixing. The inputs are not stopped.
+func (c *Client) Stop() error {
+	close(c.stopChan)
+	<-c.stopChan
+
+	return nil
+}
+
+// resample returns up to count samples from the input converted to the output sample rate
+func (c *Client) resample(in *input, count int) ([]float64, error) {
+	step := float64(in.Format.SampleRateHertz) / float64(c.options.Format.SampleRateHertz)
+	needed := int(math.Ceil(in.pos+step*float64(count-1))) + 2
+
+	for !in.eof && len(in.pending) < needed {
+		byData, err := in.Source.Read()
+		if err == io.EOF {
+			klog.V(4).Infof("Input %s EOF\n", in.Name)
+			in.eof = true
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		in.decode(byData)
+	}
+
+	samples := make([]float64, 0, count)
+	for i := 0; i < count; i++ {
+		x := in.pos + step*float64(i)
+		idx := int(x)
+		if idx >= len(in.pending) {
+			break
+		}
+		if idx+1 >= len(in.pending) {
+			samples = append(samples, in.pending[idx])
+			continue
+		}
+
+		frac := x - float64(idx)
+		samples = append(samples, in.pending[idx]*(1-frac)+in.pending[idx+1]*frac)
+	}
+
+	// drop consumed samples
+	in.pos += step * float64(len(samples))
+	consumed := int(in.pos)
+	if consumed > len(in.pending) {
+		consumed = len(in.pending)
+	}
+	in.pending = in.pending[consumed:]
+	in.pos -= float64(consumed)
+	if in.eof && len(samples) < count {
+		in.pending = nil
+	}
+
+	return samples, nil
+}
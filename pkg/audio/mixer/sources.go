@@ -0,0 +1,41 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Implementation for an audio mixer which combines microphone, replay and text-to-speech sources
+*/
+package mixer
+
+import (
+	"encoding/binary"
+
+	micinterfaces "github.com/symblai/symbl-go-sdk/pkg/audio/microphone/interfaces"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/mixer/interfaces"
+)
+
+// microphoneSource adapts the int16 samples from a Microphone into raw LINEAR16 audio
+type microphoneSource struct {
+	mic micinterfaces.Microphone
+}
+
+// NewMicrophoneSource allows a started Microphone to be used as an Input. The Input Format
+// should be EncodingLinear16 with the sample rate and channels the Microphone was created with.
+func NewMicrophoneSource(mic micinterfaces.Microphone) interfaces.Source {
+	return &microphoneSource{
+		mic: mic,
+	}
+}
+
+func (s *microphoneSource) Read() ([]byte, error) {
+	samples, err := s.mic.Read()
+	if err != nil {
+		return []byte{}, err
+	}
+
+	byData := make([]byte, len(samples)*2)
+	for i, v := range samples {
+		binary.LittleEndian.PutUint16(byData[i*2:], uint16(v))
+	}
+	return byData, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Implementation for an audio mixer which combines microphone, replay and text-to-speech sources
+*/
+package mixer
+
+import (
+	"sync"
+	"time"
+
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/mixer/interfaces"
+)
+
+// MixerOpts defines the output of the mixer
+type MixerOpts struct {
+	// Format of the mixed output. Output is always a single channel. Defaults to 8000 Hz LINEAR16
+	Format interfaces.Format
+
+	// FrameDuration is the amount of audio produced by each Read. Defaults to 20ms
+	FrameDuration time.Duration
+}
+
+// Input is an audio source to be mixed
+type Input struct {
+	// Name identifies the input when changing the gain
+	Name string
+
+	// Source produces the raw audio. The source must already be started
+	Source interfaces.Source
+
+	// Format of the audio produced by Source. Channels defaults to 1
+	Format interfaces.Format
+
+	// Gain applied to the input where 1.0 is unchanged. Defaults to 1.0
+	Gain float64
+}
+
+// input is the mixer state for a single Input
+type input struct {
+	Input
+
+	// decoded samples at the input sample rate
+	pending  []float64
+	pos      float64
+	leftover []byte
+	eof      bool
+}
+
+// Client mixes multiple audio sources into a single stream
+type Client struct {
+	options MixerOpts
+
+	// inputs
+	inputs  []*input
+	started bool
+
+	// operational stuff
+	stopChan chan struct{}
+	mute     sync.Mutex
+	muted    bool
+	read     sync.Mutex
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Implementation for an audio mixer which combines microphone, replay and text-to-speech sources
+*/
+package mixer
+
+import (
+	"encoding/binary"
+
+	g711 "github.com/zaf/g711"
+
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/mixer/interfaces"
+)
+
+// decode appends the raw audio to the pending samples as mono
+func (in *input) decode(byData []byte) {
+	byData = append(in.leftover, byData...)
+
+	sampleSize := bytesPerSample(in.Format.Encoding)
+	frameSize := sampleSize * in.Format.Channels
+	usable := len(byData) - len(byData)%frameSize
+	in.leftover = append([]byte{}, byData[usable:]...)
+
+	pcm := byData[:usable]
+	switch in.Format.Encoding {
+	case interfaces.EncodingMulaw:
+		pcm = g711.DecodeUlaw(pcm)
+	case interfaces.EncodingAlaw:
+		pcm = g711.DecodeAlaw(pcm)
+	}
+
+	channels := in.Format.Channels
+	for i := 0; i+channels*2 <= len(pcm); i += channels * 2 {
+		var sum float64
+		for ch := 0; ch < channels; ch++ {
+			sum += float64(int16(binary.LittleEndian.Uint16(pcm[i+ch*2:])))
+		}
+		in.pending = append(in.pending, sum/float64(channels))
+	}
+}
+
+// encode converts samples to the encoding
+func encode(pcm []int16, encoding interfaces.Encoding) []byte {
+	byData := make([]byte, len(pcm)*2)
+	for i, v := range pcm {
+		binary.LittleEndian.PutUint16(byData[i*2:], uint16(v))
+	}
+
+	switch encoding {
+	case interfaces.EncodingMulaw:
+		return g711.EncodeUlaw(byData)
+	case interfaces.EncodingAlaw:
+		return g711.EncodeAlaw(byData)
+	}
+	return byData
+}
+
+func bytesPerSample(encoding interfaces.Encoding) int {
+	if encoding == interfaces.EncodingLinear16 {
+		return 2
+	}
+	return 1
+}
+
+func isSupported(encoding interfaces.Encoding) bool {
+	switch encoding {
+	case interfaces.EncodingLinear16, interfaces.EncodingMulaw, interfaces.EncodingAlaw:
+		return true
+	}
+	return false
+}
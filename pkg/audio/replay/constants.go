@@ -18,4 +18,10 @@ const (
 var (
 	// ErrInvalidInput required input was not found
 	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrNotStarted the replay device has not been started
+	ErrNotStarted = errors.New("replay has not been started")
+
+	// ErrInvalidOffset the offset is outside of the audio file
+	ErrInvalidOffset = errors.New("offset is outside of the audio")
 )
@@ -7,13 +7,18 @@
 */
 package interfaces
 
-import "io"
+import (
+	"io"
+	"time"
+)
 
 // Replay defines an implementation to replay audio
 type Replay interface {
 	Start() error
 	Read() ([]byte, error)
 	Stream(w io.Writer) error
+	Seek(offset time.Duration) error
+	Position() time.Duration
 	Mute()
 	Unmute()
 	Stop() error
@@ -8,9 +8,12 @@
 package replay
 
 import (
+	"encoding/binary"
 	"io"
 	"os"
+	"time"
 
+	riff "github.com/youpy/go-riff"
 	wav "github.com/youpy/go-wav"
 	klog "k8s.io/klog/v2"
 )
@@ -44,31 +47,152 @@ func New(opts ReplayOpts) (*Client, error) {
 
 // Start begins streaming the audio for the device
 func (c *Client) Start() error {
-	reader := wav.NewReader(c.file)
-	if reader == nil {
-		klog.V(1).Infof("ReplayClient.New wav.NewDecoder is nil\n")
-		klog.V(6).Infof("Replay.New LEAVE\n")
+	riffChunk, err := riff.NewReader(c.file).Read()
+	if err != nil {
+		klog.V(1).Infof("riff.Read failed. Err: %v\n", err)
+		return err
+	}
+
+	var format *wav.WavFormat
+	var data *io.SectionReader
+	for _, chunk := range riffChunk.Chunks {
+		switch string(chunk.ChunkID) {
+		case "fmt ":
+			format = &wav.WavFormat{}
+			err := binary.Read(chunk, binary.LittleEndian, format)
+			if err != nil {
+				klog.V(1).Infof("binary.Read failed. Err: %v\n", err)
+				return err
+			}
+		case "data":
+			data = io.NewSectionReader(chunk, 0, int64(chunk.ChunkSize))
+		}
+	}
+	if format == nil || data == nil || format.ByteRate == 0 || format.BlockAlign == 0 {
+		klog.V(1).Infof("ReplayClient.Start invalid wav file\n")
 		return ErrInvalidInput
 	}
 
 	// housekeeping
-	c.decoder = reader
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	c.format = format
+	c.data = data
+
+	c.segmentStart = c.offsetToBytes(c.options.StartOffset)
+	c.segmentEnd = data.Size()
+	if c.options.Duration > 0 {
+		if end := c.segmentStart + c.offsetToBytes(c.options.Duration); end < c.segmentEnd {
+			c.segmentEnd = end
+		}
+	}
+	if c.segmentStart >= c.segmentEnd {
+		klog.V(1).Infof("StartOffset %v is past the end of the audio\n", c.options.StartOffset)
+		return ErrInvalidOffset
+	}
+
+	c.loopsLeft = c.options.LoopCount - 1
+
+	_, err = c.data.Seek(c.segmentStart, io.SeekStart)
+	if err != nil {
+		klog.V(1).Infof("data.Seek failed. Err: %v\n", err)
+		return err
+	}
 
 	return nil
 }
 
 // Read bits from the replay device
 func (c *Client) Read() ([]byte, error) {
-	buf := make([]byte, defaultBytesToRead)
+	c.read.Lock()
+	defer c.read.Unlock()
 
-	byteCount, err := c.decoder.Read(buf)
+	if c.data == nil {
+		klog.V(1).Infof("ReplayClient.Read not started\n")
+		return []byte{}, ErrNotStarted
+	}
+
+	pos, err := c.data.Seek(0, io.SeekCurrent)
 	if err != nil {
-		klog.V(1).Infof("byteBuf.Read failed. Err: %v\n", err)
+		klog.V(1).Infof("data.Seek failed. Err: %v\n", err)
 		return []byte{}, err
 	}
-	klog.V(7).Infof("byteBuf.Read bytes copied: %d\n", byteCount)
 
-	return buf, nil
+	if pos >= c.segmentEnd {
+		if c.loopsLeft <= 0 {
+			return []byte{}, io.EOF
+		}
+		c.loopsLeft--
+
+		klog.V(4).Infof("Looping replay. Loops left: %d\n", c.loopsLeft)
+		pos, err = c.data.Seek(c.segmentStart, io.SeekStart)
+		if err != nil {
+			klog.V(1).Infof("data.Seek failed. Err: %v\n", err)
+			return []byte{}, err
+		}
+	}
+
+	size := int64(defaultBytesToRead)
+	if remaining := c.segmentEnd - pos; remaining < size {
+		size = remaining
+	}
+	buf := make([]byte, size)
+
+	byteCount, err := c.data.Read(buf)
+	if err == io.EOF && byteCount > 0 {
+		err = nil
+	}
+	if err != nil {
+		klog.V(1).Infof("data.Read failed. Err: %v\n", err)
+		return []byte{}, err
+	}
+	klog.V(7).Infof("data.Read bytes copied: %d\n", byteCount)
+
+	return buf[:byteCount], nil
+}
+
+// Seek moves the replay to the offset from the start of the audio file
+func (c *Client) Seek(offset time.Duration) error {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.data == nil {
+		klog.V(1).Infof("ReplayClient.Seek not started\n")
+		return ErrNotStarted
+	}
+
+	pos := c.offsetToBytes(offset)
+	if offset < 0 || pos > c.data.Size() {
+		klog.V(1).Infof("offset %v is outside of the audio\n", offset)
+		return ErrInvalidOffset
+	}
+
+	_, err := c.data.Seek(pos, io.SeekStart)
+	if err != nil {
+		klog.V(1).Infof("data.Seek failed. Err: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// Position returns the current offset from the start of the audio file
+func (c *Client) Position() time.Duration {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.data == nil {
+		return 0
+	}
+
+	pos, err := c.data.Seek(0, io.SeekCurrent)
+	if err != nil {
+		klog.V(1).Infof("data.Seek failed. Err: %v\n", err)
+		return 0
+	}
+
+	return time.Duration(pos * int64(time.Second) / int64(c.format.ByteRate))
 }
 
 // Stream is a helper function to stream the replay device data to a source
@@ -126,7 +250,9 @@ func (c *Client) Unmute() {
 
 // Stop terminates the playback on the replay device
 func (c *Client) Stop() error {
-	c.decoder = nil
+	c.read.Lock()
+	c.data = nil
+	c.read.Unlock()
 
 	if c.file != nil {
 		c.file.Close()
@@ -138,3 +264,9 @@ func (c *Client) Stop() error {
 
 	return nil
 }
+
+// offsetToBytes converts a time offset into a block aligned byte offset
+func (c *Client) offsetToBytes(offset time.Duration) int64 {
+	pos := int64(offset) * int64(c.format.ByteRate) / int64(time.Second)
+	return pos - pos%int64(c.format.BlockAlign)
+}
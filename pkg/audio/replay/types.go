@@ -8,8 +8,10 @@
 package replay
 
 import (
+	"io"
 	"os"
 	"sync"
+	"time"
 
 	wav "github.com/youpy/go-wav"
 )
@@ -17,6 +19,15 @@ import (
 // ReplayOpts defines options for this device
 type ReplayOpts struct {
 	FullFilename string
+
+	// StartOffset is where in the file the replay begins
+	StartOffset time.Duration
+
+	// Duration limits the replay to a segment starting at StartOffset. 0 replays to the end of the file
+	Duration time.Duration
+
+	// LoopCount is the number of times the segment is replayed. 0 or 1 replays it once
+	LoopCount int
 }
 
 // Client is a replay device. In this case, an audio stream.
@@ -24,11 +35,18 @@ type Client struct {
 	options ReplayOpts
 
 	// wav
-	file    *os.File
-	decoder *wav.Reader
+	file   *os.File
+	format *wav.WavFormat
+	data   *io.SectionReader
+
+	// segment being replayed as byte offsets into data
+	segmentStart int64
+	segmentEnd   int64
+	loopsLeft    int
 
 	// operational stuff
 	stopChan chan struct{}
 	mute     sync.Mutex
 	muted    bool
+	read     sync.Mutex
 }
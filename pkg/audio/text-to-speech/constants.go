@@ -10,15 +10,20 @@ package texttospeech
 import (
 	"errors"
 
-	texttospeechpb "google.golang.org/genproto/googleapis/cloud/texttospeech/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
 )
 
 const (
-	defaultBytesToRead int = 2048
+	defaultBytesToRead  int = 2048
+	defaultMaxChunkSize int = 4800
 
-	SpeechVoiceNeutral = texttospeechpb.SsmlVoiceGender_NEUTRAL
-	SpeechVoiceFemale  = texttospeechpb.SsmlVoiceGender_FEMALE
-	SpeechVoiceMale    = texttospeechpb.SsmlVoiceGender_MALE
+	SpeechVoiceNeutral = interfaces.SsmlVoiceGenderNeutral
+	SpeechVoiceFemale  = interfaces.SsmlVoiceGenderFemale
+	SpeechVoiceMale    = interfaces.SsmlVoiceGenderMale
+
+	EncodingLinear16 = interfaces.AudioEncodingLinear16
+	EncodingMulaw    = interfaces.AudioEncodingMulaw
+	EncodingAlaw     = interfaces.AudioEncodingAlaw
 
 	DefaultLanguageCode string = "en-US"
 )
@@ -26,4 +31,13 @@ const (
 var (
 	// ErrInvalidInput required input was not found
 	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrNotStarted the audio has not been generated yet
+	ErrNotStarted = errors.New("text-to-speech has not been started")
+
+	// ErrSSMLTooLong the SSML has a <p> or <s> element, or text outside of one, longer than MaxChunkSize
+	ErrSSMLTooLong = errors.New("ssml cannot be split into chunks of the maximum size")
+
+	// ErrInvalidOffset the offset is outside of the generated audio
+	ErrInvalidOffset = errors.New("offset is outside of the audio")
 )
@@ -0,0 +1,130 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Google Cloud text-to-speech Synthesizer
+*/
+package google
+
+import (
+	"context"
+	"encoding/binary"
+	"os"
+
+	texttospeech "cloud.google.com/go/texttospeech/apiv1"
+	texttospeechpb "google.golang.org/genproto/googleapis/cloud/texttospeech/v1"
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
+)
+
+// New creates a Synthesizer backed by Google Cloud. Requires GOOGLE_APPLICATION_CREDENTIALS to be set.
+func New(ctx context.Context) (*Synthesizer, error) {
+	klog.V(6).Infof("GoogleSynthesizer.New ENTER\n")
+
+	var googleApplicationCredentials string
+	if v := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); v != "" {
+		klog.V(4).Info("GOOGLE_APPLICATION_CREDENTIALS found")
+		googleApplicationCredentials = v
+	} else {
+		klog.Error("GOOGLE_APPLICATION_CREDENTIALS not found")
+		klog.V(6).Infof("GoogleSynthesizer.New LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	googleClient, err := texttospeech.NewClient(ctx)
+	if err != nil {
+		klog.V(1).Infof("texttospeech.NewClient failed. Err: %v\n", err)
+		klog.V(6).Infof("GoogleSynthesizer.New LEAVE\n")
+		return nil, err
+	}
+
+	s := &Synthesizer{
+		speechClient:                 googleClient,
+		googleApplicationCredentials: googleApplicationCredentials,
+	}
+
+	klog.V(3).Infof("GoogleSynthesizer.New Succeeded\n")
+	klog.V(6).Infof("GoogleSynthesizer.New LEAVE\n")
+
+	return s, nil
+}
+
+// Synthesize converts the text in the request into audio
+func (s *Synthesizer) Synthesize(ctx context.Context, synthReq *interfaces.SynthesisRequest) ([]byte, error) {
+	klog.V(6).Infof("GoogleSynthesizer.Synthesize ENTER\n")
+
+	// Set the text or SSML input to be synthesized.
+	input := &texttospeechpb.SynthesisInput{}
+	if synthReq.SSML != "" {
+		input.InputSource = &texttospeechpb.SynthesisInput_Ssml{Ssml: synthReq.SSML}
+	} else {
+		input.InputSource = &texttospeechpb.SynthesisInput_Text{Text: synthReq.Text}
+	}
+
+	// Perform the text-to-speech request on the text input with the selected
+	// voice parameters and audio file type.
+	req := texttospeechpb.SynthesizeSpeechRequest{
+		Input: input,
+		// Build the voice request, select the language code ("en-US"), the voice name
+		// and the SSML voice gender ("neutral").
+		Voice: &texttospeechpb.VoiceSelectionParams{
+			LanguageCode: synthReq.LanguageCode,
+			Name:         synthReq.VoiceName,
+			SsmlGender:   texttospeechpb.SsmlVoiceGender(synthReq.VoiceType),
+		},
+		// Select the type of audio file you want returned.
+		AudioConfig: &texttospeechpb.AudioConfig{
+			AudioEncoding:   texttospeechpb.AudioEncoding(synthReq.Encoding),
+			SampleRateHertz: synthReq.SampleRateHertz,
+			SpeakingRate:    synthReq.SpeakingRate,
+			Pitch:           synthReq.Pitch,
+		},
+	}
+
+	resp, err := s.speechClient.SynthesizeSpeech(ctx, &req)
+	if err != nil {
+		klog.V(1).Infof("speechClient.SynthesizeSpeech Failed. Err: %v\n", err)
+		klog.V(6).Infof("GoogleSynthesizer.Synthesize LEAVE\n")
+		return nil, err
+	}
+
+	// LINEAR16, MULAW and ALAW are returned with a WAV header
+	audio := stripWavHeader(resp.AudioContent)
+
+	klog.V(3).Infof("GoogleSynthesizer.Synthesize Succeeded\n")
+	klog.V(6).Infof("GoogleSynthesizer.Synthesize LEAVE\n")
+	return audio, nil
+}
+
+// Close releases the connection to Google Cloud
+func (s *Synthesizer) Close() error {
+	return s.speechClient.Close()
+}
+
+// stripWavHeader returns the contents of the data chunk when audio is a WAV file
+func stripWavHeader(audio []byte) []byte {
+	if len(audio) < 12 || string(audio[0:4]) != "RIFF" || string(audio[8:12]) != "WAVE" {
+		return audio
+	}
+
+	pos := 12
+	for pos+8 <= len(audio) {
+		id := string(audio[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(audio[pos+4 : pos+8]))
+		pos += 8
+
+		if id == "data" {
+			if size > len(audio)-pos {
+				size = len(audio) - pos
+			}
+			return audio[pos : pos+size]
+		}
+
+		// chunks are word aligned
+		pos += size + size%2
+	}
+
+	return audio
+}
@@ -0,0 +1,18 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Google Cloud text-to-speech Synthesizer
+*/
+package google
+
+import (
+	texttospeech "cloud.google.com/go/texttospeech/apiv1"
+)
+
+// Synthesizer generates speech using Google Cloud text-to-speech
+type Synthesizer struct {
+	speechClient                 *texttospeech.Client
+	googleApplicationCredentials string
+}
@@ -7,6 +7,27 @@
 */
 package interfaces
 
+// AudioEncoding is the encoding of the audio generated by a Synthesizer
+type AudioEncoding int32
+
+const (
+	AudioEncodingUnspecified AudioEncoding = 0
+	AudioEncodingLinear16    AudioEncoding = 1
+	AudioEncodingMulaw       AudioEncoding = 5
+	AudioEncodingAlaw        AudioEncoding = 6
+)
+
+// SsmlVoiceGender is the gender of the voice used by a Synthesizer
+type SsmlVoiceGender int32
+
+const (
+	SsmlVoiceGenderUnspecified SsmlVoiceGender = 0
+	SsmlVoiceGenderMale        SsmlVoiceGender = 1
+	SsmlVoiceGenderFemale      SsmlVoiceGender = 2
+	SsmlVoiceGenderNeutral     SsmlVoiceGender = 3
+)
+
 var (
 	DefaultSampleRateHertz int32 = 8000
+	DefaultAudioEncoding         = AudioEncodingMulaw
 )
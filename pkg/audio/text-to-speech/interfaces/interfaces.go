@@ -7,14 +7,28 @@
 */
 package interfaces
 
-import "io"
+import (
+	"context"
+	"io"
+	"time"
+)
 
 // Interface for taking text and converting to audio/speech
 type Replay interface {
 	Start() error
 	Read() ([]byte, error)
 	Stream(w io.Writer) error
+	Seek(offset time.Duration) error
+	Position() time.Duration
 	Mute()
 	Unmute()
 	Stop() error
 }
+
+// Synthesizer is a text-to-speech backend which converts text into raw audio.
+// The returned audio must not contain any container headers (ie WAV) so that
+// audio from multiple requests can be concatenated.
+type Synthesizer interface {
+	Synthesize(ctx context.Context, req *SynthesisRequest) ([]byte, error)
+	Close() error
+}
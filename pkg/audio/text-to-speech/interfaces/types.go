@@ -0,0 +1,24 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Interface for text-to-speech
+*/
+package interfaces
+
+// SynthesisRequest contains everything a Synthesizer needs to generate audio
+type SynthesisRequest struct {
+	// only one of Text or SSML is set
+	Text string
+	SSML string
+
+	LanguageCode string
+	VoiceName    string
+	VoiceType    SsmlVoiceGender
+	SpeakingRate float64
+	Pitch        float64
+
+	Encoding        AudioEncoding
+	SampleRateHertz int32
+}
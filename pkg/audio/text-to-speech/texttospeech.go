@@ -11,104 +11,138 @@ import (
 	"bytes"
 	"context"
 	"io"
-	"os"
+	"time"
 
-	texttospeech "cloud.google.com/go/texttospeech/apiv1"
-	texttospeechpb "google.golang.org/genproto/googleapis/cloud/texttospeech/v1"
 	klog "k8s.io/klog/v2"
 
+	google "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/google"
 	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
 )
 
-// New creates a new text-to-speech Client
+// New creates a new text-to-speech Client using Google Cloud as the backend
 func New(ctx context.Context, opts SpeechOpts) (*Client, error) {
 	klog.V(6).Infof("TTSClient.New ENTER\n")
 
+	synthesizer, err := google.New(ctx)
+	if err != nil {
+		klog.V(1).Infof("google.New failed. Err: %v\n", err)
+		klog.V(6).Infof("TTSClient.New LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("TTSClient.New LEAVE\n")
+
+	return NewWithSynthesizer(ctx, opts, synthesizer)
+}
+
+// NewWithSynthesizer creates a new text-to-speech Client using the provided backend
+func NewWithSynthesizer(ctx context.Context, opts SpeechOpts, synthesizer interfaces.Synthesizer) (*Client, error) {
+	klog.V(6).Infof("TTSClient.NewWithSynthesizer ENTER\n")
+
+	if synthesizer == nil {
+		klog.V(1).Infof("Synthesizer is nil\n")
+		klog.V(6).Infof("TTSClient.NewWithSynthesizer LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
 	if opts.LanguageCode == "" {
 		opts.LanguageCode = DefaultLanguageCode
 	}
 	if opts.VoiceType == 0 {
 		opts.VoiceType = SpeechVoiceNeutral
 	}
-
-	var googleApplicationCredentials string
-	if v := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); v != "" {
-		klog.V(4).Info("GOOGLE_APPLICATION_CREDENTIALS found")
-		googleApplicationCredentials = v
-	} else {
-		klog.Error("GOOGLE_APPLICATION_CREDENTIALS not found")
-		klog.V(6).Infof("TTSClient.New LEAVE\n")
-		return nil, ErrInvalidInput
+	if opts.Encoding == interfaces.AudioEncodingUnspecified {
+		opts.Encoding = interfaces.DefaultAudioEncoding
 	}
-
-	googleClient, err := texttospeech.NewClient(ctx)
-	if err != nil {
-		klog.V(1).Infof("texttospeech.NewClient failed. Err: %v\n", err)
-		klog.V(6).Infof("TTSClient LEAVE\n")
-		return nil, err
+	if opts.SampleRateHertz == 0 {
+		opts.SampleRateHertz = interfaces.DefaultSampleRateHertz
+	}
+	if opts.MaxChunkSize <= 0 {
+		opts.MaxChunkSize = defaultMaxChunkSize
 	}
 
 	client := &Client{
-		options:                      opts,
-		speechClient:                 googleClient,
-		googleApplicationCredentials: googleApplicationCredentials,
-		stopChan:                     make(chan struct{}),
-		muted:                        false,
+		options:     opts,
+		synthesizer: synthesizer,
+		stopChan:    make(chan struct{}),
+		muted:       false,
 	}
 
-	klog.V(3).Infof("TTSClient.New Succeeded\n")
-	klog.V(6).Infof("TTSClient.New LEAVE\n")
+	klog.V(3).Infof("TTSClient.NewWithSynthesizer Succeeded\n")
+	klog.V(6).Infof("TTSClient.NewWithSynthesizer LEAVE\n")
 
 	return client, nil
 }
 
-// Start begins the audio playback of the converted text
+// Start begins the audio playback of the converted text. Text or SSML longer than MaxChunkSize
+// is synthesized in multiple requests and the audio is concatenated in order. SSML is split on
+// <p> and <s> boundaries.
 func (c *Client) Start() error {
 	klog.V(6).Infof("TTSClient.Start ENTER\n")
-	klog.V(4).Infof("text: %s\n", c.options.Text)
 
 	ctx := context.Background()
 
-	// Perform the text-to-speech request on the text input with the selected
-	// voice parameters and audio file type.
-	req := texttospeechpb.SynthesizeSpeechRequest{
-		// Set the text input to be synthesized.
-		Input: &texttospeechpb.SynthesisInput{
-			InputSource: &texttospeechpb.SynthesisInput_Text{Text: c.options.Text},
-		},
-		// Build the voice request, select the language code ("en-US") and the SSML
-		// voice gender ("neutral").
-		Voice: &texttospeechpb.VoiceSelectionParams{
-			LanguageCode: c.options.LanguageCode,
-			SsmlGender:   c.options.VoiceType,
-		},
-		// Select the type of audio file you want returned.
-		// TODO: hardcoded since we only support MULAW currently
-		AudioConfig: &texttospeechpb.AudioConfig{
-			AudioEncoding:   texttospeechpb.AudioEncoding_MULAW,
-			SampleRateHertz: interfaces.DefaultSampleRateHertz,
-		},
-	}
-
-	resp, err := c.speechClient.SynthesizeSpeech(ctx, &req)
-	if err != nil {
-		klog.V(1).Infof("speechClient.SynthesizeSpeech Failed. Err: %v\n", err)
-		klog.V(6).Infof("TTSClient.TextToSpeech LEAVE\n")
-		return err
+	var requests []*interfaces.SynthesisRequest
+	if c.options.SSML != "" {
+		klog.V(4).Infof("ssml: %s\n", c.options.SSML)
+		chunks, err := splitSSML(c.options.SSML, c.options.MaxChunkSize)
+		if err != nil {
+			klog.V(1).Infof("splitSSML failed. Err: %v\n", err)
+			klog.V(6).Infof("TTSClient.Start LEAVE\n")
+			return err
+		}
+		for _, chunk := range chunks {
+			requests = append(requests, c.newRequest("", chunk))
+		}
+	} else {
+		klog.V(4).Infof("text: %s\n", c.options.Text)
+		for _, chunk := range splitText(c.options.Text, c.options.MaxChunkSize) {
+			requests = append(requests, c.newRequest(chunk, ""))
+		}
+	}
+
+	if len(requests) == 0 {
+		klog.V(1).Infof("Text or SSML is required\n")
+		klog.V(6).Infof("TTSClient.Start LEAVE\n")
+		return ErrInvalidInput
+	}
+
+	var audio []byte
+	for i, req := range requests {
+		klog.V(4).Infof("Synthesizing chunk %d of %d\n", i+1, len(requests))
+
+		chunk, err := c.synthesizer.Synthesize(ctx, req)
+		if err != nil {
+			klog.V(1).Infof("synthesizer.Synthesize Failed. Err: %v\n", err)
+			klog.V(6).Infof("TTSClient.Start LEAVE\n")
+			return err
+		}
+
+		audio = append(audio, chunk...)
 	}
 
 	// save to a reader
-	klog.V(4).Infof("Bytes generated: %d\n", len(resp.AudioContent))
-	c.byteBuf = bytes.NewReader(resp.AudioContent)
+	klog.V(4).Infof("Bytes generated: %d\n", len(audio))
+	c.read.Lock()
+	c.byteBuf = bytes.NewReader(audio)
+	c.read.Unlock()
 
-	klog.V(3).Infof("TTSClient.TextToSpeech Succeeded\n")
-	klog.V(6).Infof("TTSClient.TextToSpeech LEAVE\n")
+	klog.V(3).Infof("TTSClient.Start Succeeded\n")
+	klog.V(6).Infof("TTSClient.Start LEAVE\n")
 	return nil
 
 }
 
 // Read gets the raw bits of audio playback
 func (c *Client) Read() ([]byte, error) {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.byteBuf == nil {
+		klog.V(1).Infof("TTSClient.Read not started\n")
+		return []byte{}, ErrNotStarted
+	}
+
 	klog.V(7).Infof("byteBuf Size: %d\n", c.byteBuf.Len())
 	buf := make([]byte, defaultBytesToRead)
 
@@ -119,7 +153,47 @@ func (c *Client) Read() ([]byte, error) {
 	}
 	klog.V(7).Infof("TTSClient.Read bytes copied: %d\n", cnt)
 
-	return buf, nil
+	return buf[:cnt], nil
+}
+
+// Seek moves the playback to the offset from the start of the generated audio
+func (c *Client) Seek(offset time.Duration) error {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.byteBuf == nil {
+		klog.V(1).Infof("TTSClient.Seek not started\n")
+		return ErrNotStarted
+	}
+
+	bytesPerSample := c.bytesPerSample()
+	pos := int64(offset) * c.bytesPerSecond() / int64(time.Second)
+	pos -= pos % bytesPerSample
+	if offset < 0 || pos > c.byteBuf.Size() {
+		klog.V(1).Infof("offset %v is outside of the audio\n", offset)
+		return ErrInvalidOffset
+	}
+
+	_, err := c.byteBuf.Seek(pos, io.SeekStart)
+	if err != nil {
+		klog.V(1).Infof("byteBuf.Seek failed. Err: %v\n", err)
+		return err
+	}
+
+	return nil
+}
+
+// Position returns the current offset from the start of the generated audio
+func (c *Client) Position() time.Duration {
+	c.read.Lock()
+	defer c.read.Unlock()
+
+	if c.byteBuf == nil {
+		return 0
+	}
+
+	pos := c.byteBuf.Size() - int64(c.byteBuf.Len())
+	return time.Duration(pos * int64(time.Second) / c.bytesPerSecond())
 }
 
 // Stream is a helper function to stream audio to a playback device
@@ -177,10 +251,35 @@ func (c *Client) Unmute() {
 
 // Stop terminates the audio playback
 func (c *Client) Stop() error {
-	c.speechClient.Close()
+	c.synthesizer.Close()
 
 	close(c.stopChan)
 	<-c.stopChan
 
 	return nil
 }
+
+func (c *Client) newRequest(text, ssml string) *interfaces.SynthesisRequest {
+	return &interfaces.SynthesisRequest{
+		Text:            text,
+		SSML:            ssml,
+		LanguageCode:    c.options.LanguageCode,
+		VoiceName:       c.options.VoiceName,
+		VoiceType:       c.options.VoiceType,
+		SpeakingRate:    c.options.SpeakingRate,
+		Pitch:           c.options.Pitch,
+		Encoding:        c.options.Encoding,
+		SampleRateHertz: c.options.SampleRateHertz,
+	}
+}
+
+func (c *Client) bytesPerSample() int64 {
+	if c.options.Encoding == interfaces.AudioEncodingLinear16 {
+		return 2
+	}
+	return 1
+}
+
+func (c *Client) bytesPerSecond() int64 {
+	return int64(c.options.SampleRateHertz) * c.bytesPerSample()
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Offline tone generating Synthesizer used for testing
+*/
+package tone
+
+import (
+	"errors"
+	"regexp"
+	"time"
+)
+
+const (
+	defaultFrequency    float64       = 440
+	defaultAmplitude    float64       = 0.5
+	defaultWordDuration time.Duration = 250 * time.Millisecond
+	defaultGapDuration  time.Duration = 100 * time.Millisecond
+)
+
+var (
+	// ErrUnsupportedEncoding the requested audio encoding is not supported by this synthesizer
+	ErrUnsupportedEncoding = errors.New("unsupported audio encoding")
+
+	// ssmlTags matches markup so only the spoken words in SSML are counted
+	ssmlTags = regexp.MustCompile(`<[^>]*>`)
+)
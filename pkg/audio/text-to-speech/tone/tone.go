@@ -0,0 +1,114 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Offline tone generating Synthesizer used for testing
+*/
+package tone
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+
+	g711 "github.com/zaf/g711"
+	klog "k8s.io/klog/v2"
+
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
+)
+
+// New creates a tone Synthesizer
+func New(opts ToneOpts) *Synthesizer {
+	if opts.Frequency <= 0 {
+		opts.Frequency = defaultFrequency
+	}
+	if opts.Amplitude <= 0 || opts.Amplitude > 1 {
+		opts.Amplitude = defaultAmplitude
+	}
+	if opts.WordDuration <= 0 {
+		opts.WordDuration = defaultWordDuration
+	}
+	if opts.GapDuration <= 0 {
+		opts.GapDuration = defaultGapDuration
+	}
+
+	return &Synthesizer{
+		options: opts,
+	}
+}
+
+// Synthesize generates one tone per word in the text followed by a short silence
+func (s *Synthesizer) Synthesize(ctx context.Context, req *interfaces.SynthesisRequest) ([]byte, error) {
+	klog.V(6).Infof("ToneSynthesizer.Synthesize ENTER\n")
+
+	sampleRate := req.SampleRateHertz
+	if sampleRate <= 0 {
+		sampleRate = interfaces.DefaultSampleRateHertz
+	}
+
+	rate := req.SpeakingRate
+	if rate <= 0 {
+		rate = 1.0
+	}
+
+	toneSamples := samplesFor(time.Duration(float64(s.options.WordDuration)/rate), sampleRate)
+	gapSamples := samplesFor(time.Duration(float64(s.options.GapDuration)/rate), sampleRate)
+
+	text := req.Text
+	if req.SSML != "" {
+		text = ssmlTags.ReplaceAllString(req.SSML, " ")
+	}
+
+	words := strings.Fields(text)
+	pcm := make([]byte, len(words)*(toneSamples+gapSamples)*2)
+	offset := 0
+
+	for _, word := range words {
+		select {
+		case <-ctx.Done():
+			klog.V(1).Infof("ToneSynthesizer.Synthesize cancelled. Err: %v\n", ctx.Err())
+			klog.V(6).Infof("ToneSynthesizer.Synthesize LEAVE\n")
+			return nil, ctx.Err()
+		default:
+		}
+
+		klog.V(7).Infof("word: %s\n", word)
+		for i := 0; i < toneSamples; i++ {
+			v := s.options.Amplitude * math.Sin(2*math.Pi*s.options.Frequency*float64(i)/float64(sampleRate))
+			binary.LittleEndian.PutUint16(pcm[offset:], uint16(int16(v*math.MaxInt16)))
+			offset += 2
+		}
+		offset += gapSamples * 2
+	}
+
+	var audio []byte
+	switch req.Encoding {
+	case interfaces.AudioEncodingLinear16:
+		audio = pcm
+	case interfaces.AudioEncodingMulaw, interfaces.AudioEncodingUnspecified:
+		audio = g711.EncodeUlaw(pcm)
+	case interfaces.AudioEncodingAlaw:
+		audio = g711.EncodeAlaw(pcm)
+	default:
+		klog.V(1).Infof("Encoding %d is not supported\n", req.Encoding)
+		klog.V(6).Infof("ToneSynthesizer.Synthesize LEAVE\n")
+		return nil, ErrUnsupportedEncoding
+	}
+
+	klog.V(4).Infof("Bytes generated: %d\n", len(audio))
+	klog.V(3).Infof("ToneSynthesizer.Synthesize Succeeded\n")
+	klog.V(6).Infof("ToneSynthesizer.Synthesize LEAVE\n")
+	return audio, nil
+}
+
+// Close is a no-op since no resources are held
+func (s *Synthesizer) Close() error {
+	return nil
+}
+
+func samplesFor(d time.Duration, sampleRate int32) int {
+	return int(d.Seconds() * float64(sampleRate))
+}
@@ -0,0 +1,29 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Offline tone generating Synthesizer used for testing
+*/
+package tone
+
+import (
+	"time"
+)
+
+// ToneOpts defines options for the generated tones
+type ToneOpts struct {
+	// Frequency of the tone in Hz
+	Frequency float64
+	// Amplitude of the tone between 0.0 and 1.0
+	Amplitude float64
+	// WordDuration is the length of the tone emitted for each word
+	WordDuration time.Duration
+	// GapDuration is the length of the silence between words
+	GapDuration time.Duration
+}
+
+// Synthesizer emits a tone for every word in the text without requiring any network access
+type Synthesizer struct {
+	options ToneOpts
+}
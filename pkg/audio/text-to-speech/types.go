@@ -11,24 +11,42 @@ import (
 	"bytes"
 	"sync"
 
-	texttospeech "cloud.google.com/go/texttospeech/apiv1"
-	texttospeechpb "google.golang.org/genproto/googleapis/cloud/texttospeech/v1"
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/audio/text-to-speech/interfaces"
 )
 
 // SpeechOpts contains options for the voice output
 type SpeechOpts struct {
-	VoiceType    texttospeechpb.SsmlVoiceGender
+	VoiceType    interfaces.SsmlVoiceGender
 	LanguageCode string
 	Text         string
+
+	// SSML is used instead of Text when provided
+	SSML string
+
+	// VoiceName selects a specific voice (ie en-US-Wavenet-D)
+	VoiceName string
+
+	// SpeakingRate of 1.0 is normal speed. 0 uses the provider default
+	SpeakingRate float64
+
+	// Pitch in semitones from the original pitch. 0 is the provider default
+	Pitch float64
+
+	// MaxChunkSize is the max number of bytes of Text or SSML sent in a single synthesis request.
+	// Longer text is split on sentence boundaries and SSML on <p> and <s> boundaries. 0 uses the default
+	MaxChunkSize int
+
+	// output format. defaults to MULAW at 8000 Hz
+	Encoding        interfaces.AudioEncoding
+	SampleRateHertz int32
 }
 
 // Client is the object which connects to a text-to-speech platform to generate an audio file
 type Client struct {
 	options SpeechOpts
 
-	// google stuff
-	speechClient                 *texttospeech.Client
-	googleApplicationCredentials string
+	// backend which generates the audio
+	synthesizer interfaces.Synthesizer
 
 	// operational stuff
 	stopChan chan struct{}
@@ -37,4 +55,5 @@ type Client struct {
 
 	// raw buffer
 	byteBuf *bytes.Reader
+	read    sync.Mutex
 }
@@ -0,0 +1,213 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+ Implementation for text-to-speech
+*/
+package texttospeech
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// splitText breaks text into chunks of at most maxSize bytes preferring sentence
+// boundaries, then word boundaries and finally splitting words that are too long
+func splitText(text string, maxSize int) []string {
+	text = strings.TrimSpace(text)
+	if len(text) == 0 {
+		return nil
+	}
+	if len(text) <= maxSize {
+		return []string{text}
+	}
+
+	var chunks []string
+	var current strings.Builder
+
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+	}
+	add := func(piece string) {
+		if current.Len() > 0 && current.Len()+1+len(piece) > maxSize {
+			flush()
+		}
+		if current.Len() > 0 {
+			current.WriteByte(' ')
+		}
+		current.WriteString(piece)
+	}
+
+	for _, sentence := range splitSentences(text) {
+		if len(sentence) <= maxSize {
+			add(sentence)
+			continue
+		}
+
+		// sentence is too long on its own
+		flush()
+		for _, word := range strings.Fields(sentence) {
+			for len(word) > maxSize {
+				cut := maxSize
+				for cut > 0 && !utf8.RuneStart(word[cut]) {
+					cut--
+				}
+				if cut == 0 {
+					// maxSize is smaller than the rune so keep the rune whole
+					_, cut = utf8.DecodeRuneInString(word)
+				}
+				add(word[:cut])
+				flush()
+				word = word[cut:]
+			}
+			add(word)
+		}
+		flush()
+	}
+	flush()
+
+	return chunks
+}
+
+// splitSentences splits text after sentence terminating punctuation followed by whitespace
+func splitSentences(text string) []string {
+	var sentences []string
+
+	start := 0
+	for pos, r := range text {
+		if r != '.' && r != '!' && r != '?' {
+			continue
+		}
+
+		end := pos + 1
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if end >= len(text) || !unicode.IsSpace(next) {
+			continue
+		}
+
+		if sentence := strings.TrimSpace(text[start:end]); len(sentence) > 0 {
+			sentences = append(sentences, sentence)
+		}
+		start = end
+	}
+	if sentence := strings.TrimSpace(text[start:]); len(sentence) > 0 {
+		sentences = append(sentences, sentence)
+	}
+
+	return sentences
+}
+
+var (
+	ssmlSpeakRegex = regexp.MustCompile(`(?s)^(<speak\b[^>]*>)(.*)(</speak>)$`)
+	ssmlBlockRegex = regexp.MustCompile(`<(/?)(p|s)\b[^>]*>`)
+)
+
+// splitSSML breaks a SSML document into documents of at most maxSize bytes. Paragraphs and
+// sentences are kept whole and each chunk is wrapped in the original <speak> element.
+// Paragraphs which are too long are split into their sentences.
+func splitSSML(ssml string, maxSize int) ([]string, error) {
+	ssml = strings.TrimSpace(ssml)
+	if len(ssml) == 0 {
+		return nil, nil
+	}
+	if len(ssml) <= maxSize {
+		return []string{ssml}, nil
+	}
+
+	open, body, end := "", ssml, ""
+	if m := ssmlSpeakRegex.FindStringSubmatch(ssml); m != nil {
+		open, body, end = m[1], m[2], m[3]
+	}
+
+	var pieces []string
+	for _, block := range splitSSMLBlocks(body) {
+		if len(open)+len(block)+len(end) <= maxSize {
+			pieces = append(pieces, block)
+			continue
+		}
+
+		// split a long paragraph into its sentences, each wrapped in the paragraph element
+		sentences, ok := splitSSMLParagraph(block)
+		if !ok {
+			return nil, ErrSSMLTooLong
+		}
+		pieces = append(pieces, sentences...)
+	}
+
+	var chunks []string
+	var current strings.Builder
+	flush := func() {
+		if current.Len() > 0 {
+			chunks = append(chunks, open+current.String()+end)
+			current.Reset()
+		}
+	}
+	for _, piece := range pieces {
+		if len(open)+len(piece)+len(end) > maxSize {
+			return nil, ErrSSMLTooLong
+		}
+		if len(open)+current.Len()+len(piece)+len(end) > maxSize {
+			flush()
+		}
+		current.WriteString(piece)
+	}
+	flush()
+
+	return chunks, nil
+}
+
+// splitSSMLBlocks splits after each top level </p> or </s>
+func splitSSMLBlocks(body string) []string {
+	var blocks []string
+
+	depth, start := 0, 0
+	for _, loc := range ssmlBlockRegex.FindAllStringSubmatchIndex(body, -1) {
+		tag := body[loc[0]:loc[1]]
+		switch {
+		case strings.HasSuffix(tag, "/>"):
+			continue
+		case loc[3] > loc[2]:
+			depth--
+		default:
+			depth++
+		}
+		if depth == 0 {
+			if block := strings.TrimSpace(body[start:loc[1]]); len(block) > 0 {
+				blocks = append(blocks, block)
+			}
+			start = loc[1]
+		}
+	}
+	if block := strings.TrimSpace(body[start:]); len(block) > 0 {
+		blocks = append(blocks, block)
+	}
+
+	return blocks
+}
+
+// splitSSMLParagraph splits a <p> element into one <p> element per sentence
+func splitSSMLParagraph(block string) ([]string, bool) {
+	if !strings.HasPrefix(block, "<p") || !strings.HasSuffix(block, "</p>") {
+		return nil, false
+	}
+	openEnd := strings.Index(block, ">") + 1
+	open := block[:openEnd]
+	inner := block[openEnd : len(block)-len("</p>")]
+
+	sentences := splitSSMLBlocks(inner)
+	if len(sentences) < 2 {
+		return nil, false
+	}
+
+	paragraphs := make([]string, 0, len(sentences))
+	for _, sentence := range sentences {
+		paragraphs = append(paragraphs, open+sentence+"</p>")
+	}
+	return paragraphs, true
+}
@@ -43,6 +43,17 @@ func WithCustomParameters(ctx context.Context, params map[string][]string) conte
 	return context.WithValue(ctx, ParametersContext{}, params)
 }
 
+// UploadProgress is called as bytes are uploaded. total is -1 when the size is unknown
+type UploadProgress func(sent, total int64)
+
+// UploadProgressContext blackbox of data
+type UploadProgressContext struct{}
+
+// WithUploadProgress appends an upload progress callback to the given context
+func WithUploadProgress(ctx context.Context, progress UploadProgress) context.Context {
+	return context.WithValue(ctx, UploadProgressContext{}, progress)
+}
+
 /*
 	RawResponse may be used with the Do method as the resBody argument in order
 	to capture the raw response data.
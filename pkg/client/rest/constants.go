@@ -8,10 +8,19 @@ import (
 	"errors"
 )
 
+const (
+	sniffLen int = 512
+
+	defaultUploadName string = "upload"
+)
+
 var (
 	// ErrInvalidInput required input was not found
 	ErrInvalidInput = errors.New("required input was not found")
 
 	// ErrInvalidURIExtension couldn't find a period to indicate a file extension
 	ErrInvalidURIExtension = errors.New("couldn't find a period to indicate a file extension")
+
+	// ErrInvalidMediaType media type must be either audio or video
+	ErrInvalidMediaType = errors.New("media type must be either audio or video")
 )
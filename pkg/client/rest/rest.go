@@ -5,6 +5,7 @@
 package rest
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -378,72 +379,118 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, ufRe
 	}
 	defer file.Close()
 
-	// start: until multipart post is supported, options must be used as a query string
-	params := make(map[string][]string, 0)
-
-	if len(ufRequest.Name) > 0 {
-		params["name"] = []string{ufRequest.Name}
-	}
-	if ufRequest.ConfidenceThreshold > 0 {
-		params["confidenceThreshold"] = []string{fmt.Sprintf("%f", ufRequest.ConfidenceThreshold)}
-	}
-	// TODO: channelMetadata... need to see what that looks like as a query string param
-	if ufRequest.DetectPhrases {
-		params["detectPhrases"] = []string{fmt.Sprintf("%t", ufRequest.DetectPhrases)}
-	}
-	if ufRequest.DetectEntities {
-		params["detectEntities"] = []string{fmt.Sprintf("%t", ufRequest.DetectEntities)}
-	}
-	if len(ufRequest.LanguageCode) > 0 {
-		params["languageCode"] = []string{ufRequest.LanguageCode}
-	}
-	if len(ufRequest.CustomVocabulary) > 0 {
-		params["customVocabulary"] = ufRequest.CustomVocabulary
-	}
-	if ufRequest.Sentiment {
-		params["mode"] = []string{ufRequest.Mode}
+	err = c.doCommonReader(ctx, apiURI, baseName, bufio.NewReaderSize(file, sniffLen), fileInfo.Size(), ufRequest, resBody)
+	if err != nil {
+		klog.V(1).Infof("doCommonReader failed. Err: %v\n", err)
+		klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+		return err
 	}
-	if ufRequest.ParentRefs {
-		params["parentRefs"] = []string{fmt.Sprintf("%t", ufRequest.ParentRefs)}
+
+	klog.V(3).Infof("rest.doCommonFile Succeeded\n")
+	klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+	return nil
+}
+
+// DoReader posts a conversation read from an io.Reader to a given REST endpoint. Set size to -1 when
+// the size is unknown. mediaType is either audio or video and is detected from the content when empty.
+func (c *Client) DoReader(ctx context.Context, r io.Reader, size int64, mediaType string, ufRequest asyncinterfaces.AsyncURLFileRequest, resBody interface{}) error {
+	klog.V(6).Infof("rest.DoReader ENTER\n")
+
+	// checks
+	if r == nil {
+		klog.V(1).Infof("reader is nil\n")
+		klog.V(6).Infof("rest.DoReader LEAVE\n")
+		return ErrInvalidInput
 	}
-	if ufRequest.Sentiment {
-		params["sentiment"] = []string{fmt.Sprintf("%t", ufRequest.Sentiment)}
+
+	buffered := bufio.NewReaderSize(r, sniffLen)
+
+	if len(mediaType) == 0 {
+		contentType := sniffContentType(buffered)
+		mediaType = mediaTypeFromContentType(contentType)
+		klog.V(3).Infof("Detected Content-Type: %s, mediaType: %s\n", contentType, mediaType)
 	}
-	if ufRequest.Sentiment {
-		params["enableSeparateRecognitionPerChannel"] = []string{fmt.Sprintf("%t", ufRequest.EnableSeparateRecognitionPerChannel)}
+
+	var apiURI string
+	switch mediaType {
+	case common.MediaTypeAudio:
+		apiURI = version.ProcessAudioURI
+	case common.MediaTypeVideo:
+		apiURI = version.ProcessVideoURI
+	default:
+		klog.V(1).Infof("Invalid mediaType: %s\n", mediaType)
+		klog.V(6).Infof("rest.DoReader LEAVE\n")
+		return ErrInvalidMediaType
 	}
-	if ufRequest.Sentiment {
-		params["enableSpeakerDiarization"] = []string{fmt.Sprintf("%t", ufRequest.EnableSpeakerDiarization)}
+
+	name := ufRequest.Name
+	if len(name) == 0 {
+		name = defaultUploadName
 	}
-	if ufRequest.Sentiment {
-		params["diarizationSpeakerCount"] = []string{fmt.Sprintf("%d", ufRequest.DiarizationSpeakerCount)}
+
+	err := c.doCommonReader(ctx, apiURI, name, buffered, size, ufRequest, resBody)
+	if err != nil {
+		klog.V(1).Infof("doCommonReader failed. Err: %v\n", err)
+		klog.V(6).Infof("rest.DoReader LEAVE\n")
+		return err
 	}
-	if ufRequest.Sentiment {
-		params["webhookUrl"] = []string{ufRequest.WebhookURL}
+
+	klog.V(3).Infof("rest.DoReader Succeeded\n")
+	klog.V(6).Infof("rest.DoReader LEAVE\n")
+	return nil
+}
+
+func (c *Client) doCommonReader(ctx context.Context, apiURI, name string, r *bufio.Reader, size int64, ufRequest asyncinterfaces.AsyncURLFileRequest, resBody interface{}) error {
+	klog.V(6).Infof("rest.doCommonReader ENTER\n")
+	klog.V(4).Infof("rest.doCommonReader apiURI: %s\n", apiURI)
+	klog.V(4).Infof("name: %s, size: %d\n", name, size)
+
+	if size <= 0 {
+		size = -1
 	}
-	// end
 
-	URI := fmt.Sprintf("%s%s",
-		version.GetAsyncAPI(apiURI, baseName),
-		c.getQueryParamFromContext(ctx, &params))
+	contentType := sniffContentType(r)
+	klog.V(4).Infof("Detected Content-Type: %s\n", contentType)
+
+	// until multipart post is supported, options must be used as a query string
+	params := structToQueryParams(ufRequest, "name", "url")
+
+	URI := version.GetAsyncAPI(apiURI, url.QueryEscape(name))
+	if queryString := c.getQueryParamFromContext(ctx, &params); len(queryString) > 0 {
+		URI = fmt.Sprintf("%s&%s", URI, queryString)
+	}
 	klog.V(6).Infof("Calling %s\n", URI)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", URI, file)
+	progress, _ := ctx.Value(interfaces.UploadProgressContext{}).(interfaces.UploadProgress)
+	body := &progressReader{
+		ctx:      ctx,
+		reader:   r,
+		progress: progress,
+		total:    size,
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", URI, body)
 	if err != nil {
 		klog.V(1).Infof("http.NewRequestWithContext failed. Err: %v\n", err)
-		klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+		klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 		return err
 	}
+	req.ContentLength = size
 
 	if headers, ok := ctx.Value(interfaces.HeadersContext{}).(http.Header); ok {
 		for k, v := range headers {
 			for _, v := range v {
-				klog.V(3).Infof("doCommonFile() Custom Header: %s = %s\n", k, v)
+				klog.V(3).Infof("doCommonReader() Custom Header: %s = %s\n", k, v)
 				req.Header.Add(k, v)
 			}
 		}
 	}
 
+	if strings.HasPrefix(contentType, "audio/") || strings.HasPrefix(contentType, "video/") {
+		klog.V(3).Infof("Content-Type = %s\n", contentType)
+		req.Header.Set("Content-Type", contentType)
+	}
+
 	req.Header.Set("Accept", "application/json")
 	if c.auth != nil && c.auth.NebulaToken != "" {
 		req.Header.Set("ApiKey", c.auth.NebulaToken)
@@ -461,10 +508,10 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, ufRe
 			detail, errBody := io.ReadAll(res.Body)
 			if err != nil {
 				klog.V(4).Infof("io.ReadAll failed. Err: %e\n", errBody)
-				klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+				klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 				return &interfaces.StatusError{res}
 			}
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return fmt.Errorf("%s: %s", res.Status, bytes.TrimSpace(detail))
 		default:
 			return &interfaces.StatusError{res}
@@ -472,36 +519,36 @@ func (c *Client) doCommonFile(ctx context.Context, apiURI, filePath string, ufRe
 
 		if resBody == nil {
 			klog.V(1).Infof("resBody == nil\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return nil
 		}
 
 		switch b := resBody.(type) {
 		case *interfaces.RawResponse:
 			klog.V(3).Infof("RawResponse\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return res.Write(b)
 		case io.Writer:
 			klog.V(3).Infof("io.Writer\n")
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			_, err := io.Copy(b, res.Body)
 			return err
 		default:
 			klog.V(3).Infof("json.NewDecoder\n")
 			d := json.NewDecoder(res.Body)
-			klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+			klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 			return d.Decode(resBody)
 		}
 	})
 
 	if err != nil {
 		klog.V(1).Infof("err = c.Client.Do failed. Err: %v\n", err)
-		klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+		klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 		return err
 	}
 
-	klog.V(3).Infof("rest.doCommonFile Succeeded\n")
-	klog.V(6).Infof("rest.doCommonFile LEAVE\n")
+	klog.V(3).Infof("rest.doCommonReader Succeeded\n")
+	klog.V(6).Infof("rest.doCommonReader LEAVE\n")
 	return nil
 }
 
@@ -576,9 +623,10 @@ func (c *Client) doCommonURL(ctx context.Context, apiURI string, ufRequest async
 		ufRequest.Name = baseName
 	}
 
-	URI := fmt.Sprintf("%s%s",
-		version.GetAsyncAPI(apiURI),
-		c.getQueryParamFromContext(ctx, nil))
+	URI := version.GetAsyncAPI(apiURI)
+	if queryString := c.getQueryParamFromContext(ctx, nil); len(queryString) > 0 {
+		URI = fmt.Sprintf("%s?%s", URI, queryString)
+	}
 	klog.V(6).Infof("Calling %s\n", URI)
 
 	var buf bytes.Buffer
@@ -765,41 +813,12 @@ func (c *Client) getQueryParamFromContext(ctx context.Context, input *map[string
 		}
 	}
 
-	// TODO: replace with https://github.com/google/go-querystring
-	// API differs from how go-querystring works
-	//
-	//	go-querystring : []vals -> key=vals[0]&key=vals[1]
-	//	symbl API: []vals -> key=["$vals[0]", "$vals[1]"]
-	//
-	// need to look into switching that behavior in order to use go-querystring lib
-	if len(*input) > 1 {
-		queryString := "&"
-		for k, vs := range *input {
-			if len(queryString) > 3 {
-				queryString += "&"
-			}
-			if len(vs) == 1 {
-				queryString += fmt.Sprintf("%s=%s", k, vs[0])
-			} else {
-				appended := false
-				for _, v := range vs {
-					if !appended {
-						queryString += fmt.Sprintf("%s=[", k)
-						appended = true
-					} else {
-						queryString += ","
-					}
-					queryString += fmt.Sprintf("%s", v)
-				}
-				if len(vs) > 0 {
-					queryString += "]"
-				}
-			}
-		}
-		klog.V(5).Infof("Final Query String: %s\n", queryString)
-		return queryString
+	queryString := EncodeQueryParams(*input)
+	if len(queryString) == 0 {
+		klog.V(6).Infof("Final Query String is Empty\n")
+		return ""
 	}
 
-	klog.V(6).Infof("Final Query String is Empty\n")
-	return ""
+	klog.V(5).Infof("Final Query String: %s\n", queryString)
+	return queryString
 }
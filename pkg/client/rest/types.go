@@ -5,8 +5,12 @@
 package rest
 
 import (
+	"context"
+	"io"
 	"time"
 
+	interfaces "github.com/symblai/symbl-go-sdk/pkg/client/interfaces"
+
 	simple "github.com/symblai/symbl-go-sdk/pkg/client/simple"
 )
 
@@ -23,3 +27,12 @@ type Client struct {
 
 	auth *AccessToken
 }
+
+// progressReader reports upload progress and aborts the upload when the context is cancelled
+type progressReader struct {
+	ctx      context.Context
+	reader   io.Reader
+	progress interfaces.UploadProgress
+	sent     int64
+	total    int64
+}
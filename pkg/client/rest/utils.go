@@ -0,0 +1,169 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	common "github.com/symblai/symbl-go-sdk/pkg/api/common"
+)
+
+// Read implements io.Reader
+func (r *progressReader) Read(p []byte) (int, error) {
+	if err := r.ctx.Err(); err != nil {
+		klog.V(1).Infof("upload cancelled. Err: %v\n", err)
+		return 0, err
+	}
+
+	n, err := r.reader.Read(p)
+	if n > 0 {
+		r.sent += int64(n)
+		if r.progress != nil {
+			r.progress(r.sent, r.total)
+		}
+	}
+	return n, err
+}
+
+// sniffContentType detects the content type from the first bytes of the reader without consuming them
+func sniffContentType(r *bufio.Reader) string {
+	header, _ := r.Peek(sniffLen)
+
+	// MPEG audio frames without an ID3 tag aren't detected by http.DetectContentType
+	if len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0 {
+		return "audio/mpeg"
+	}
+
+	return http.DetectContentType(header)
+}
+
+// mediaTypeFromContentType maps a content type to the audio or video processing endpoints
+func mediaTypeFromContentType(contentType string) string {
+	switch {
+	case strings.HasPrefix(contentType, "audio/"):
+		return common.MediaTypeAudio
+	case contentType == "application/ogg":
+		return common.MediaTypeAudio
+	}
+
+	// assume video
+	return common.MediaTypeVideo
+}
+
+// structToQueryParams converts the non-zero fields of a struct into query parameters keyed by
+// their json tag so the query string and JSON body representations can't drift apart. Scalars
+// are formatted as is and everything else (slices, structs, maps) is JSON encoded.
+func structToQueryParams(v interface{}, skip ...string) map[string][]string {
+	params := make(map[string][]string, 0)
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return params
+	}
+
+	skipped := make(map[string]bool, len(skip))
+	for _, key := range skip {
+		skipped[key] = true
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		key := strings.Split(field.Tag.Get("json"), ",")[0]
+		if key == "-" || skipped[key] {
+			continue
+		}
+		if len(key) == 0 {
+			key = field.Name
+		}
+
+		value, ok := formatQueryValue(rv.Field(i))
+		if !ok {
+			continue
+		}
+
+		klog.V(5).Infof("Key/Value: %s = %s\n", key, value)
+		params[key] = []string{value}
+	}
+
+	return params
+}
+
+// formatQueryValue returns false when the value is empty and should be omitted
+func formatQueryValue(v reflect.Value) (string, bool) {
+	if v.IsZero() {
+		return "", false
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64), true
+	case reflect.Slice, reflect.Map:
+		if v.Len() == 0 {
+			return "", false
+		}
+	}
+
+	byData, err := json.Marshal(v.Interface())
+	if err != nil {
+		klog.V(1).Infof("json.Marshal failed. Err: %v\n", err)
+		return "", false
+	}
+	if string(byData) == "{}" {
+		return "", false
+	}
+
+	return string(byData), true
+}
+
+// EncodeQueryParams builds an escaped query string sorted by key. Multiple values for the same key
+// are sent in the platform's list format: key=[val1,val2]
+func EncodeQueryParams(params map[string][]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vs := params[k]
+
+		var value string
+		switch len(vs) {
+		case 0:
+			continue
+		case 1:
+			value = vs[0]
+		default:
+			value = "[" + strings.Join(vs, ",") + "]"
+		}
+
+		pairs = append(pairs, url.QueryEscape(k)+"="+url.QueryEscape(value))
+	}
+
+	return strings.Join(pairs, "&")
+}
@@ -0,0 +1,291 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	common "github.com/symblai/symbl-go-sdk/pkg/api/common"
+)
+
+// redirectTransport sends every request to the test server regardless of the platform host
+type redirectTransport struct {
+	target *url.URL
+}
+
+func (t redirectTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// newTestClient returns a client whose requests are served by the returned server
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	target, err := url.Parse(srv.URL)
+	if err != nil {
+		t.Fatalf("url.Parse failed. Err: %v", err)
+	}
+
+	c := New()
+	c.Client.Client.Transport = redirectTransport{target: target}
+	return c
+}
+
+func TestDoReaderQueryParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		request asyncinterfaces.AsyncURLFileRequest
+		want    url.Values
+	}{
+		{
+			name:    "zero values are omitted",
+			request: asyncinterfaces.AsyncURLFileRequest{},
+			want: url.Values{
+				"name": {defaultUploadName},
+			},
+		},
+		{
+			name: "scalars",
+			request: asyncinterfaces.AsyncURLFileRequest{
+				Name:                                "meeting",
+				ConfidenceThreshold:                 0.75,
+				DetectPhrases:                       true,
+				WebhookURL:                          "https://example.com/hook?id=1&kind=job",
+				DetectEntities:                      true,
+				LanguageCode:                        "en-US",
+				Mode:                                "phone",
+				EnableSeparateRecognitionPerChannel: true,
+				EnableSpeakerDiarization:            true,
+				DiarizationSpeakerCount:             3,
+				ParentRefs:                          true,
+				Sentiment:                           true,
+				ConversationType:                    "sales",
+			},
+			want: url.Values{
+				"name":                                {"meeting"},
+				"confidenceThreshold":                 {"0.75"},
+				"detectPhrases":                       {"true"},
+				"webhookUrl":                          {"https://example.com/hook?id=1&kind=job"},
+				"detectEntities":                      {"true"},
+				"languageCode":                        {"en-US"},
+				"mode":                                {"phone"},
+				"enableSeparateRecognitionPerChannel": {"true"},
+				"enableSpeakerDiarization":            {"true"},
+				"diarizationSpeakerCount":             {"3"},
+				"parentRefs":                          {"true"},
+				"sentiment":                           {"true"},
+				"conversationType":                    {"sales"},
+			},
+		},
+		{
+			name: "lists and objects are JSON encoded",
+			request: asyncinterfaces.AsyncURLFileRequest{
+				CustomVocabulary: []string{"Symbl", "A&B"},
+				ChannelMetadata: []asyncinterfaces.ChannelMetadata{
+					{
+						Speaker: asyncinterfaces.Speaker{Name: "John Doe", Email: "john@example.com"},
+						Channel: 1,
+					},
+				},
+				Features: asyncinterfaces.Features{
+					FeatureList: []string{"insights", "callScore"},
+				},
+				Metadata: asyncinterfaces.Metadata{
+					SalesStage:   "qualification",
+					ProspectName: "Acme + Co",
+				},
+			},
+			want: url.Values{
+				"name":             {defaultUploadName},
+				"customVocabulary": {`["Symbl","A\u0026B"]`},
+				"channelMetadata":  {`[{"speaker":{"name":"John Doe","email":"john@example.com"},"channel":1}]`},
+				"features":         {`{"featureList":["insights","callScore"]}`},
+				"metadata":         {`{"salesStage":"qualification","prospectName":"Acme + Co"}`},
+			},
+		},
+		{
+			name: "empty lists and objects are omitted",
+			request: asyncinterfaces.AsyncURLFileRequest{
+				Name:             "meeting",
+				CustomVocabulary: []string{},
+				ChannelMetadata:  []asyncinterfaces.ChannelMetadata{},
+				Features:         asyncinterfaces.Features{FeatureList: []string{}},
+			},
+			want: url.Values{
+				"name": {"meeting"},
+			},
+		},
+		{
+			name: "url is never sent for uploads",
+			request: asyncinterfaces.AsyncURLFileRequest{
+				Name: "meeting",
+				URL:  "https://example.com/meeting.mp3",
+			},
+			want: url.Values{
+				"name": {"meeting"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got url.Values
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.Query()
+				w.WriteHeader(http.StatusOK)
+			})
+
+			err := c.DoReader(context.Background(), strings.NewReader("audio"), 5, common.MediaTypeAudio, tt.request, nil)
+			if err != nil {
+				t.Fatalf("DoReader failed. Err: %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("query mismatch\n got: %v\nwant: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStructToQueryParams(t *testing.T) {
+	type nested struct {
+		Value string `json:"value,omitempty"`
+	}
+	type request struct {
+		Text     string   `json:"text,omitempty"`
+		Flag     bool     `json:"flag,omitempty"`
+		Count    int      `json:"count,omitempty"`
+		Ratio    float64  `json:"ratio,omitempty"`
+		List     []string `json:"list,omitempty"`
+		Nested   nested   `json:"nested,omitempty"`
+		Untagged string
+		Ignored  string `json:"-"`
+		skipped  string
+	}
+
+	tests := []struct {
+		name  string
+		input interface{}
+		skip  []string
+		want  map[string][]string
+	}{
+		{
+			name:  "zero values are omitted",
+			input: request{},
+			want:  map[string][]string{},
+		},
+		{
+			name: "all fields",
+			input: &request{
+				Text:     "hello",
+				Flag:     true,
+				Count:    -2,
+				Ratio:    0.5,
+				List:     []string{"a", "b"},
+				Nested:   nested{Value: "x"},
+				Untagged: "y",
+				Ignored:  "z",
+				skipped:  "z",
+			},
+			want: map[string][]string{
+				"text":     {"hello"},
+				"flag":     {"true"},
+				"count":    {"-2"},
+				"ratio":    {"0.5"},
+				"list":     {`["a","b"]`},
+				"nested":   {`{"value":"x"}`},
+				"Untagged": {"y"},
+			},
+		},
+		{
+			name:  "skip keys",
+			input: request{Text: "hello", Count: 1},
+			skip:  []string{"text"},
+			want: map[string][]string{
+				"count": {"1"},
+			},
+		},
+		{
+			name:  "not a struct",
+			input: "hello",
+			want:  map[string][]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := structToQueryParams(tt.input, tt.skip...)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("params mismatch\n got: %v\nwant: %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEncodeQueryParams(t *testing.T) {
+	tests := []struct {
+		name   string
+		params map[string][]string
+		want   string
+	}{
+		{
+			name:   "empty",
+			params: map[string][]string{},
+			want:   "",
+		},
+		{
+			name: "sorted by key",
+			params: map[string][]string{
+				"b": {"2"},
+				"a": {"1"},
+			},
+			want: "a=1&b=2",
+		},
+		{
+			name: "reserved characters are escaped",
+			params: map[string][]string{
+				"webhookUrl": {"https://example.com/hook?id=1&kind=job"},
+				"name":       {"a b+c#d"},
+			},
+			want: "name=a+b%2Bc%23d&webhookUrl=https%3A%2F%2Fexample.com%2Fhook%3Fid%3D1%26kind%3Djob",
+		},
+		{
+			name: "multiple values use the list format",
+			params: map[string][]string{
+				"exclude": {"a", "b"},
+			},
+			want: "exclude=%5Ba%2Cb%5D",
+		},
+		{
+			name: "keys without values are omitted",
+			params: map[string][]string{
+				"empty": {},
+				"name":  {"x"},
+			},
+			want: "name=x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EncodeQueryParams(tt.params)
+			if got != tt.want {
+				t.Errorf("query mismatch\n got: %s\nwant: %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"os"
 	"time"
@@ -215,6 +216,11 @@ func (c *RestClient) DoFileWithOptions(ctx context.Context, filePath string, ufR
 	return c.Client.DoFile(ctx, filePath, ufRequest, resBody)
 }
 
+// DoReaderWithOptions wrapper function for REST Client. Please see pkg/client/rest
+func (c *RestClient) DoReaderWithOptions(ctx context.Context, r io.Reader, size int64, mediaType string, ufRequest asyncinterfaces.AsyncURLFileRequest, resBody interface{}) error {
+	return c.Client.DoReader(ctx, r, size, mediaType, ufRequest, resBody)
+}
+
 // DoURLWithOptions wrapper function for REST Client. Please see pkg/client/rest
 func (c *RestClient) DoURLWithOptions(ctx context.Context, ufRequest asyncinterfaces.AsyncURLFileRequest, resBody interface{}) error {
 	return c.Client.DoURL(ctx, ufRequest, resBody)
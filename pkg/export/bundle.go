@@ -0,0 +1,130 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"sort"
+	"time"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+var (
+	bundleInsightTypes = []string{
+		asyncinterfaces.InsightTypeMessages,
+		asyncinterfaces.InsightTypeTopics,
+		asyncinterfaces.InsightTypeQuestions,
+		asyncinterfaces.InsightTypeActionItems,
+		asyncinterfaces.InsightTypeFollowUps,
+		asyncinterfaces.InsightTypeSummary,
+		asyncinterfaces.InsightTypeTrackers,
+		asyncinterfaces.InsightTypeAnalytics,
+		asyncinterfaces.InsightTypeMembers,
+	}
+)
+
+// NewBundle gathers the conversation and its insights. The conversation and messages are required;
+// any other insight that fails to be fetched is listed in Bundle.Missing.
+func NewBundle(ctx context.Context, client *async.Client, conversationId string) (*Bundle, error) {
+	klog.V(6).Infof("export.NewBundle ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client == nil || conversationId == "" {
+		klog.V(1).Infof("client or conversationId is empty\n")
+		klog.V(6).Infof("export.NewBundle LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	conversation, err := client.GetConversation(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetConversation failed. Err: %v\n", err)
+		klog.V(6).Infof("export.NewBundle LEAVE\n")
+		return nil, err
+	}
+
+	insights, err := client.FetchInsights(ctx, conversationId, bundleInsightTypes)
+	if err != nil {
+		klog.V(1).Infof("FetchInsights failed. Err: %v\n", err)
+		klog.V(6).Infof("export.NewBundle LEAVE\n")
+		return nil, err
+	}
+	if err, ok := insights.Errors[asyncinterfaces.InsightTypeMessages]; ok {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		klog.V(6).Infof("export.NewBundle LEAVE\n")
+		return nil, err
+	}
+
+	bundle := &Bundle{
+		Version:      BundleVersion,
+		ExportedAt:   time.Now().UTC(),
+		Conversation: conversation,
+		Messages:     insights.Messages,
+		Topics:       insights.Topics,
+		Questions:    insights.Questions,
+		ActionItems:  insights.ActionItems,
+		FollowUps:    insights.FollowUps,
+		Summary:      insights.Summary,
+		Trackers:     insights.Trackers,
+		Analytics:    insights.Analytics,
+		Members:      insights.Members,
+	}
+	for insightType := range insights.Errors {
+		bundle.Missing = append(bundle.Missing, insightType)
+	}
+
+	bookmarks, err := client.GetBookmarks(ctx, conversationId)
+	if err == nil {
+		bundle.Bookmarks = bookmarks
+	} else {
+		klog.V(1).Infof("GetBookmarks failed. Err: %v\n", err)
+		bundle.Missing = append(bundle.Missing, bundleInsightBookmarks)
+	}
+	sort.Strings(bundle.Missing)
+
+	klog.V(3).Infof("NewBundle succeeded. Missing: %v\n", bundle.Missing)
+	klog.V(6).Infof("export.NewBundle LEAVE\n")
+	return bundle, nil
+}
+
+// ReadBundle loads a bundle previously written by ToJSON
+func ReadBundle(r io.Reader) (*Bundle, error) {
+	klog.V(6).Infof("export.ReadBundle ENTER\n")
+
+	var bundle Bundle
+	err := json.NewDecoder(r).Decode(&bundle)
+	if err != nil {
+		klog.V(1).Infof("json.Decode failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ReadBundle LEAVE\n")
+		return nil, err
+	}
+	if bundle.Version > BundleVersion {
+		klog.V(1).Infof("Bundle version %d is not supported\n", bundle.Version)
+		klog.V(6).Infof("export.ReadBundle LEAVE\n")
+		return nil, ErrUnsupportedVersion
+	}
+
+	klog.V(6).Infof("export.ReadBundle LEAVE\n")
+	return &bundle, nil
+}
+
+// ToJSON renders the bundle as indented JSON
+func ToJSON(bundle *Bundle) ([]byte, error) {
+	if bundle == nil {
+		return nil, ErrInvalidInput
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
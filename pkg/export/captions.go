@@ -0,0 +1,211 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// BuildCues splits the messages into caption cues using word level timings when available.
+// Messages without words have their text spread evenly across the message duration.
+func BuildCues(result *asyncinterfaces.MessageResult, opts CaptionOpts) ([]Cue, error) {
+	klog.V(6).Infof("export.BuildCues ENTER\n")
+
+	if result == nil {
+		klog.V(1).Infof("MessageResult is nil\n")
+		klog.V(6).Infof("export.BuildCues LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	opts = captionDefaults(opts)
+
+	base := async.ConversationStart(result.Messages)
+
+	cues := make([]Cue, 0)
+	for _, message := range result.Messages {
+		words := messageWords(message, base)
+		cues = append(cues, wordsToCues(words, opts)...)
+	}
+
+	// extend short cues without running into the next one
+	for i := range cues {
+		end := cues[i].Start + opts.MinCueDuration
+		if end <= cues[i].End {
+			continue
+		}
+		if i+1 < len(cues) && end > cues[i+1].Start {
+			end = cues[i+1].Start
+		}
+		if end > cues[i].End {
+			cues[i].End = end
+		}
+	}
+
+	klog.V(3).Infof("BuildCues produced %d cues\n", len(cues))
+	klog.V(6).Infof("export.BuildCues LEAVE\n")
+	return cues, nil
+}
+
+// ToSRT renders the messages as SubRip captions
+func ToSRT(result *asyncinterfaces.MessageResult, opts CaptionOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ToSRT ENTER\n")
+
+	cues, err := BuildCues(result, opts)
+	if err != nil {
+		klog.V(1).Infof("BuildCues failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToSRT LEAVE\n")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for i, cue := range cues {
+		lines := cue.Lines
+		if opts.SpeakerLabels && cue.Speaker != "" {
+			lines = labelLines(cue.Speaker, lines)
+		}
+
+		fmt.Fprintf(&buf, "%d\n", i+1)
+		fmt.Fprintf(&buf, "%s --> %s\n", formatTimestamp(cue.Start, ","), formatTimestamp(cue.End, ","))
+		fmt.Fprintf(&buf, "%s\n\n", strings.Join(lines, "\n"))
+	}
+
+	klog.V(6).Infof("export.ToSRT LEAVE\n")
+	return buf.Bytes(), nil
+}
+
+// ToWebVTT renders the messages as WebVTT captions. Speaker labels use voice spans.
+func ToWebVTT(result *asyncinterfaces.MessageResult, opts CaptionOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ToWebVTT ENTER\n")
+
+	cues, err := BuildCues(result, opts)
+	if err != nil {
+		klog.V(1).Infof("BuildCues failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToWebVTT LEAVE\n")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("WEBVTT\n\n")
+	for _, cue := range cues {
+		lines := make([]string, len(cue.Lines))
+		for i, line := range cue.Lines {
+			lines[i] = escapeVTT(line)
+		}
+		if opts.SpeakerLabels && cue.Speaker != "" && len(lines) > 0 {
+			lines[0] = fmt.Sprintf("<v %s>%s", escapeVTT(cue.Speaker), lines[0])
+		}
+
+		fmt.Fprintf(&buf, "%s --> %s\n", formatTimestamp(cue.Start, "."), formatTimestamp(cue.End, "."))
+		fmt.Fprintf(&buf, "%s\n\n", strings.Join(lines, "\n"))
+	}
+
+	klog.V(6).Infof("export.ToWebVTT LEAVE\n")
+	return buf.Bytes(), nil
+}
+
+// ToTTML renders the messages as a TTML document
+func ToTTML(result *asyncinterfaces.MessageResult, opts CaptionOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ToTTML ENTER\n")
+
+	cues, err := BuildCues(result, opts)
+	if err != nil {
+		klog.V(1).Infof("BuildCues failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToTTML LEAVE\n")
+		return nil, err
+	}
+
+	language := opts.Language
+	if language == "" {
+		language = defaultLanguage
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n")
+	fmt.Fprintf(&buf, "<tt xmlns=\"http://www.w3.org/ns/ttml\" xml:lang=\"%s\">\n", escapeXML(language))
+	buf.WriteString("  <body>\n")
+	buf.WriteString("    <div>\n")
+	for _, cue := range cues {
+		lines := cue.Lines
+		if opts.SpeakerLabels && cue.Speaker != "" {
+			lines = labelLines(cue.Speaker, lines)
+		}
+
+		escaped := make([]string, len(lines))
+		for i, line := range lines {
+			escaped[i] = escapeXML(line)
+		}
+
+		fmt.Fprintf(&buf, "      <p begin=\"%s\" end=\"%s\">%s</p>\n",
+			formatTimestamp(cue.Start, "."), formatTimestamp(cue.End, "."), strings.Join(escaped, "<br/>"))
+	}
+	buf.WriteString("    </div>\n")
+	buf.WriteString("  </body>\n")
+	buf.WriteString("</tt>\n")
+
+	klog.V(6).Infof("export.ToTTML LEAVE\n")
+	return buf.Bytes(), nil
+}
+
+func wordsToCues(words []timedWord, opts CaptionOpts) []Cue {
+	cues := make([]Cue, 0)
+
+	var cue *Cue
+	line := ""
+	flush := func() {
+		if cue == nil {
+			return
+		}
+		if line != "" {
+			cue.Lines = append(cue.Lines, line)
+		}
+		cues = append(cues, *cue)
+		cue = nil
+		line = ""
+	}
+
+	for _, word := range words {
+		// a new cue is needed on speaker change or when the cue is too long
+		if cue != nil && (word.speaker != cue.Speaker || word.end-cue.Start > opts.MaxCueDuration) {
+			flush()
+		}
+
+		if cue != nil && line != "" && len(line)+1+len(word.text) > opts.MaxCharsPerLine {
+			if len(cue.Lines)+1 >= opts.MaxLines {
+				flush()
+			} else {
+				cue.Lines = append(cue.Lines, line)
+				line = ""
+			}
+		}
+
+		if cue == nil {
+			cue = &Cue{
+				Start:   word.start,
+				Speaker: word.speaker,
+			}
+		}
+
+		if line == "" {
+			line = word.text
+		} else {
+			line += " " + word.text
+		}
+		if word.end > cue.End {
+			cue.End = word.end
+		}
+	}
+	flush()
+
+	return cues
+}
@@ -0,0 +1,44 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// caption defaults
+	defaultMaxCharsPerLine int           = 42
+	defaultMaxLines        int           = 2
+	defaultMinCueDuration  time.Duration = time.Second
+	defaultMaxCueDuration  time.Duration = 7 * time.Second
+	defaultLanguage        string        = "en"
+)
+
+const (
+	// BundleVersion is the version of the bundle format written by this package
+	BundleVersion int = 1
+
+	// reported in Bundle.Missing when bookmarks could not be fetched
+	bundleInsightBookmarks string = "bookmarks"
+)
+
+var (
+	// ErrInvalidInput required input was not found
+	ErrInvalidInput = errors.New("required input was not found")
+
+	// ErrUnsupportedVersion the bundle was written by a newer version of this package
+	ErrUnsupportedVersion = errors.New("unsupported bundle version")
+
+	// ErrNoMessages the bundle does not contain any messages to import
+	ErrNoMessages = errors.New("bundle does not contain any messages")
+
+	// ErrJobNotCompleted the imported conversation did not finish processing in time
+	ErrJobNotCompleted = errors.New("imported conversation did not finish processing")
+)
@@ -0,0 +1,189 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"context"
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// Import recreates the conversation in the bundle using the messages, then recreates bookmarks and
+// member details once the new conversation is processed
+func Import(ctx context.Context, client *async.Client, bundle *Bundle, opts ImportOpts) (*ImportResult, error) {
+	klog.V(6).Infof("export.Import ENTER\n")
+
+	// checks
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if client == nil || bundle == nil {
+		klog.V(1).Infof("client or bundle is nil\n")
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+	if bundle.Version > BundleVersion {
+		klog.V(1).Infof("Bundle version %d is not supported\n", bundle.Version)
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return nil, ErrUnsupportedVersion
+	}
+	if bundle.Messages == nil || len(bundle.Messages.Messages) == 0 {
+		klog.V(1).Infof("Bundle has no messages\n")
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return nil, ErrNoMessages
+	}
+
+	result := &ImportResult{
+		BookmarkIDs: make(map[string]string),
+	}
+
+	name := opts.Name
+	if bundle.Conversation != nil {
+		result.OldConversationID = bundle.Conversation.ID
+		if name == "" {
+			name = bundle.Conversation.Name
+		}
+	}
+
+	// recreate the conversation
+	jobConvo, err := client.PostTextWithOptions(ctx, asyncinterfaces.AsyncTextRequest{
+		Name:     name,
+		Messages: toTextMessages(bundle.Messages.Messages),
+	})
+	if err != nil {
+		klog.V(1).Infof("PostTextWithOptions failed. Err: %v\n", err)
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return nil, err
+	}
+	result.NewConversationID = jobConvo.ConversationID
+	result.JobID = jobConvo.JobID
+
+	completed, err := client.WaitForJobComplete(ctx, asyncinterfaces.WaitForJobStatusOpts{
+		JobId:              jobConvo.JobID,
+		TotalWaitInSeconds: opts.TotalWaitInSeconds,
+		WaitInSeconds:      opts.WaitInSeconds,
+	})
+	if err != nil {
+		klog.V(1).Infof("WaitForJobComplete failed. Err: %v\n", err)
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return result, err
+	}
+	if !completed {
+		klog.V(1).Infof("WaitForJobComplete failed to complete\n")
+		klog.V(6).Infof("export.Import LEAVE\n")
+		return result, ErrJobNotCompleted
+	}
+
+	if !opts.SkipMembers && bundle.Members != nil {
+		result.Errors = append(result.Errors, importMembers(ctx, client, result.NewConversationID, bundle.Members.Members)...)
+	}
+	if !opts.SkipBookmarks && bundle.Bookmarks != nil {
+		result.Errors = append(result.Errors, importBookmarks(ctx, client, result, bundle)...)
+	}
+
+	klog.V(3).Infof("Import %s -> %s succeeded with %d errors\n", result.OldConversationID, result.NewConversationID, len(result.Errors))
+	klog.V(6).Infof("export.Import LEAVE\n")
+	return result, nil
+}
+
+// toTextMessages preserves the speaker and timing of each message
+func toTextMessages(messages []asyncinterfaces.Message) []asyncinterfaces.TextMessage {
+	textMessages := make([]asyncinterfaces.TextMessage, 0, len(messages))
+	for _, message := range messages {
+		textMessage := asyncinterfaces.TextMessage{
+			Payload: asyncinterfaces.Payload{
+				Content: message.Text,
+			},
+		}
+		if message.From.ID != "" || message.From.Name != "" {
+			from := message.From
+			textMessage.From = &from
+		}
+		if message.StartTime != "" && message.EndTime != "" {
+			textMessage.Duration = &asyncinterfaces.Duration{
+				StartTime: message.StartTime,
+				EndTime:   message.EndTime,
+			}
+		}
+		textMessages = append(textMessages, textMessage)
+	}
+	return textMessages
+}
+
+// importMembers restores member details such as email which aren't carried by the messages
+func importMembers(ctx context.Context, client *async.Client, conversationId string, members []asyncinterfaces.Member) []error {
+	result, err := client.GetMembers(ctx, conversationId)
+	if err != nil {
+		klog.V(1).Infof("GetMembers failed. Err: %v\n", err)
+		return []error{err}
+	}
+
+	errs := make([]error, 0)
+	for _, member := range result.Members {
+		for _, old := range members {
+			if !strings.EqualFold(old.Name, member.Name) || old.Email == "" || old.Email == member.Email {
+				continue
+			}
+
+			member.Email = old.Email
+			err := client.UpdateMember(ctx, conversationId, member)
+			if err != nil {
+				klog.V(1).Infof("UpdateMember failed. Err: %v\n", err)
+				errs = append(errs, err)
+			}
+			break
+		}
+	}
+	return errs
+}
+
+// importBookmarks recreates bookmarks. Message references are remapped by position when the new
+// conversation has the same number of messages, otherwise only the time range is kept.
+func importBookmarks(ctx context.Context, client *async.Client, result *ImportResult, bundle *Bundle) []error {
+	errs := make([]error, 0)
+
+	messageIDs := make(map[string]string)
+	newMessages, err := client.GetMessages(ctx, result.NewConversationID)
+	if err != nil {
+		klog.V(1).Infof("GetMessages failed. Err: %v\n", err)
+		errs = append(errs, err)
+	} else if len(newMessages.Messages) == len(bundle.Messages.Messages) {
+		for i, message := range bundle.Messages.Messages {
+			messageIDs[message.ID] = newMessages.Messages[i].ID
+		}
+	}
+
+	for _, bookmark := range bundle.Bookmarks.Bookmarks {
+		request := asyncinterfaces.BookmarkRequest{
+			Label:           bookmark.Label,
+			Description:     bookmark.Description,
+			User:            bookmark.User,
+			BeginTimeOffset: bookmark.BeginTimeOffset,
+			Duration:        bookmark.Duration,
+		}
+		for _, ref := range bookmark.MessageRefs {
+			if id, ok := messageIDs[ref.ID]; ok {
+				request.MessageRefs = append(request.MessageRefs, asyncinterfaces.MessageRefRequest{ID: id})
+			}
+		}
+
+		created, err := client.CreateBookmark(ctx, result.NewConversationID, request)
+		if err != nil {
+			klog.V(1).Infof("CreateBookmark failed. Err: %v\n", err)
+			errs = append(errs, err)
+			continue
+		}
+		result.BookmarkIDs[bookmark.ID] = created.ID
+	}
+
+	return errs
+}
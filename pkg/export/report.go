@@ -0,0 +1,93 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"bytes"
+	_ "embed"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+
+	klog "k8s.io/klog/v2"
+)
+
+var (
+	//go:embed templates/report.md.tmpl
+	defaultMarkdownTemplate string
+
+	//go:embed templates/report.html.tmpl
+	defaultHTMLTemplate string
+)
+
+// ToMarkdown renders the bundle as a Markdown report
+func ToMarkdown(bundle *Bundle, opts ReportOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ToMarkdown ENTER\n")
+
+	if bundle == nil {
+		klog.V(1).Infof("Bundle is nil\n")
+		klog.V(6).Infof("export.ToMarkdown LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	text := opts.Template
+	if text == "" {
+		text = defaultMarkdownTemplate
+	}
+
+	tmpl, err := texttemplate.New("report").Funcs(reportFuncs(bundle)).Parse(text)
+	if err != nil {
+		klog.V(1).Infof("template.Parse failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToMarkdown LEAVE\n")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, newReportData(bundle, opts))
+	if err != nil {
+		klog.V(1).Infof("template.Execute failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToMarkdown LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("export.ToMarkdown LEAVE\n")
+	return buf.Bytes(), nil
+}
+
+// ToHTML renders the bundle as a static HTML report
+func ToHTML(bundle *Bundle, opts ReportOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ToHTML ENTER\n")
+
+	if bundle == nil {
+		klog.V(1).Infof("Bundle is nil\n")
+		klog.V(6).Infof("export.ToHTML LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	text := opts.Template
+	if text == "" {
+		text = defaultHTMLTemplate
+	}
+
+	tmpl, err := htmltemplate.New("report").Funcs(reportFuncs(bundle)).Parse(text)
+	if err != nil {
+		klog.V(1).Infof("template.Parse failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToHTML LEAVE\n")
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, newReportData(bundle, opts))
+	if err != nil {
+		klog.V(1).Infof("template.Execute failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ToHTML LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("export.ToHTML LEAVE\n")
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	texttemplate "text/template"
+
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+//go:embed templates/score.md.tmpl
+var defaultScoreTemplate string
+
+// NewScoreReport builds a report for the call score. The baseline is optional and when present
+// every criterion also reports its change from the baseline criterion with the same name.
+func NewScoreReport(conversationId string, score *asyncinterfaces.CallScoreResult, baselineConversationId string, baseline *asyncinterfaces.CallScoreResult) (*ScoreReport, error) {
+	if score == nil {
+		klog.V(1).Infof("score is nil\n")
+		return nil, ErrInvalidInput
+	}
+
+	report := &ScoreReport{
+		ConversationID: conversationId,
+		Score:          score.Score,
+		Summary:        score.Summary,
+		Criteria:       make([]CriterionScore, 0, len(score.Criteria)),
+	}
+
+	baselineCriteria := make(map[string]float64)
+	if baseline != nil {
+		report.BaselineConversationID = baselineConversationId
+		report.BaselineScore = floatPtr(baseline.Score)
+		report.Delta = floatPtr(roundScore(score.Score - baseline.Score))
+		for _, criterion := range baseline.Criteria {
+			baselineCriteria[criterion.Name] = criterion.Score
+		}
+	}
+
+	for _, criterion := range score.Criteria {
+		item := CriterionScore{
+			Name:     criterion.Name,
+			Score:    criterion.Score,
+			Summary:  criterion.Summary,
+			Positive: criterion.Feedback.Positive.Summary,
+			Negative: criterion.Feedback.Negative.Summary,
+		}
+		if previous, ok := baselineCriteria[criterion.Name]; ok {
+			item.BaselineScore = floatPtr(previous)
+			item.Delta = floatPtr(roundScore(criterion.Score - previous))
+		}
+		report.Criteria = append(report.Criteria, item)
+	}
+
+	return report, nil
+}
+
+// ScoreReportToJSON renders the score report as indented JSON
+func ScoreReportToJSON(report *ScoreReport) ([]byte, error) {
+	if report == nil {
+		return nil, ErrInvalidInput
+	}
+	return json.MarshalIndent(report, "", "  ")
+}
+
+// ScoreReportToMarkdown renders the score report as Markdown. The template is executed with a
+// ScoreReportData.
+func ScoreReportToMarkdown(report *ScoreReport, opts ReportOpts) ([]byte, error) {
+	klog.V(6).Infof("export.ScoreReportToMarkdown ENTER\n")
+
+	if report == nil {
+		klog.V(1).Infof("ScoreReport is nil\n")
+		klog.V(6).Infof("export.ScoreReportToMarkdown LEAVE\n")
+		return nil, ErrInvalidInput
+	}
+
+	text := opts.Template
+	if text == "" {
+		text = defaultScoreTemplate
+	}
+
+	tmpl, err := texttemplate.New("score").Funcs(scoreFuncs()).Parse(text)
+	if err != nil {
+		klog.V(1).Infof("template.Parse failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ScoreReportToMarkdown LEAVE\n")
+		return nil, err
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = "Call Score"
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, &ScoreReportData{ScoreReport: report, Title: title})
+	if err != nil {
+		klog.V(1).Infof("template.Execute failed. Err: %v\n", err)
+		klog.V(6).Infof("export.ScoreReportToMarkdown LEAVE\n")
+		return nil, err
+	}
+
+	klog.V(6).Infof("export.ScoreReportToMarkdown LEAVE\n")
+	return buf.Bytes(), nil
+}
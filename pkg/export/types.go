@@ -0,0 +1,144 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"time"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// CaptionOpts controls how messages are split into caption cues
+type CaptionOpts struct {
+	// MaxCharsPerLine does not include the speaker label. Defaults to 42
+	MaxCharsPerLine int
+
+	// MaxLines per cue. Defaults to 2
+	MaxLines int
+
+	// MinCueDuration extends short cues as long as they don't overlap the next cue. Defaults to 1s
+	MinCueDuration time.Duration
+
+	// MaxCueDuration splits long cues. Defaults to 7s
+	MaxCueDuration time.Duration
+
+	// SpeakerLabels prefixes each cue with the speaker name
+	SpeakerLabels bool
+
+	// Language used in the TTML document. Defaults to en
+	Language string
+}
+
+// Cue is a single caption
+type Cue struct {
+	Start   time.Duration
+	End     time.Duration
+	Speaker string
+	Lines   []string
+}
+
+// timedWord is a word with timing relative to the start of the conversation
+type timedWord struct {
+	text    string
+	start   time.Duration
+	end     time.Duration
+	speaker string
+}
+
+// Bundle is a self-contained export of a processed conversation
+type Bundle struct {
+	Version      int                           `json:"version"`
+	ExportedAt   time.Time                     `json:"exportedAt"`
+	Conversation *asyncinterfaces.Conversation `json:"conversation,omitempty"`
+
+	Messages    *asyncinterfaces.MessageResult    `json:"messages,omitempty"`
+	Topics      *asyncinterfaces.TopicResult      `json:"topics,omitempty"`
+	Questions   *asyncinterfaces.QuestionResult   `json:"questions,omitempty"`
+	ActionItems *asyncinterfaces.ActionItemResult `json:"actionItems,omitempty"`
+	FollowUps   *asyncinterfaces.FollowUpResult   `json:"followUps,omitempty"`
+	Summary     *asyncinterfaces.SummaryResult    `json:"summary,omitempty"`
+	Trackers    *asyncinterfaces.TrackerResult    `json:"trackers,omitempty"`
+	Analytics   *asyncinterfaces.AnalyticsResult  `json:"analytics,omitempty"`
+	Members     *asyncinterfaces.MembersResult    `json:"members,omitempty"`
+	Bookmarks   *asyncinterfaces.BookmarksResult  `json:"bookmarks,omitempty"`
+
+	// Missing lists the insight types which could not be fetched
+	Missing []string `json:"missing,omitempty"`
+}
+
+// ReportOpts controls rendering of the Markdown and HTML reports
+type ReportOpts struct {
+	// Title defaults to the conversation name
+	Title string
+
+	// Template overrides the default report template. Markdown reports use text/template and
+	// HTML reports use html/template. The template is executed with a ReportData.
+	Template string
+}
+
+// ReportData is passed to the report templates
+type ReportData struct {
+	*Bundle
+	Title string
+}
+
+// ImportOpts controls how a bundle is recreated on the platform
+type ImportOpts struct {
+	// Name defaults to the name of the exported conversation
+	Name string
+
+	// TotalWaitInSeconds and WaitInSeconds control waiting for the new conversation to be processed
+	TotalWaitInSeconds int64
+	WaitInSeconds      int64
+
+	// SkipBookmarks and SkipMembers disable recreating bookmarks and member details
+	SkipBookmarks bool
+	SkipMembers   bool
+}
+
+// ImportResult maps the exported conversation to the newly created one
+type ImportResult struct {
+	OldConversationID string
+	NewConversationID string
+	JobID             string
+
+	// BookmarkIDs maps old bookmark IDs to new bookmark IDs
+	BookmarkIDs map[string]string
+
+	// Errors are failures recreating bookmarks or members. The conversation itself was imported.
+	Errors []error
+}
+
+// ScoreReport is a call score with per-criterion deltas against an optional baseline conversation
+type ScoreReport struct {
+	ConversationID         string           `json:"conversationId"`
+	BaselineConversationID string           `json:"baselineConversationId,omitempty"`
+	Score                  float64          `json:"score"`
+	BaselineScore          *float64         `json:"baselineScore,omitempty"`
+	Delta                  *float64         `json:"delta,omitempty"`
+	Summary                string           `json:"summary,omitempty"`
+	Criteria               []CriterionScore `json:"criteria"`
+}
+
+// CriterionScore is the score and feedback of a single criterion. BaselineScore and Delta are
+// nil when the baseline does not have the criterion.
+type CriterionScore struct {
+	Name          string   `json:"name"`
+	Score         float64  `json:"score"`
+	BaselineScore *float64 `json:"baselineScore,omitempty"`
+	Delta         *float64 `json:"delta,omitempty"`
+	Summary       string   `json:"summary,omitempty"`
+	Positive      string   `json:"positive,omitempty"`
+	Negative      string   `json:"negative,omitempty"`
+}
+
+// ScoreReportData is passed to the score report template
+type ScoreReportData struct {
+	*ScoreReport
+	Title string
+}
@@ -0,0 +1,224 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Export package for rendering conversation results into other formats
+*/
+package export
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	async "github.com/symblai/symbl-go-sdk/pkg/api/async/v1"
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+func newReportData(bundle *Bundle, opts ReportOpts) *ReportData {
+	title := opts.Title
+	if title == "" && bundle.Conversation != nil {
+		title = bundle.Conversation.Name
+		if title == "" {
+			title = bundle.Conversation.ID
+		}
+	}
+	if title == "" {
+		title = "Conversation"
+	}
+
+	return &ReportData{
+		Bundle: bundle,
+		Title:  title,
+	}
+}
+
+// reportFuncs are available to the report templates
+func reportFuncs(bundle *Bundle) map[string]interface{} {
+	var base time.Time
+	if bundle.Messages != nil {
+		base = async.ConversationStart(bundle.Messages.Messages)
+	}
+
+	return map[string]interface{}{
+		// offset renders a message as hh:mm:ss from the start of the conversation
+		"offset": func(message asyncinterfaces.Message) string {
+			start, _ := async.MessageTiming(message, base)
+			return formatClock(start)
+		},
+		// seconds renders a number of seconds as hh:mm:ss
+		"seconds": func(seconds float64) string {
+			return formatClock(secondsToDuration(seconds))
+		},
+		// cell escapes text for a Markdown table cell
+		"cell": escapeTableCell,
+	}
+}
+
+// scoreFuncs are available to the score report template
+func scoreFuncs() map[string]interface{} {
+	return map[string]interface{}{
+		// cell escapes text for a Markdown table cell
+		"cell": escapeTableCell,
+		// number renders a score without trailing zeros
+		"number": func(v interface{}) string {
+			switch n := v.(type) {
+			case float64:
+				return formatScore(n)
+			case *float64:
+				if n != nil {
+					return formatScore(*n)
+				}
+			}
+			return ""
+		},
+		// delta renders a change in score with its sign
+		"delta": func(d *float64) string {
+			if d == nil {
+				return ""
+			}
+			if *d > 0 {
+				return "+" + formatScore(*d)
+			}
+			return formatScore(*d)
+		},
+	}
+}
+
+func formatScore(v float64) string {
+	return strconv.FormatFloat(roundScore(v), 'f', -1, 64)
+}
+
+// roundScore rounds to two decimals so deltas don't show floating point noise
+func roundScore(v float64) float64 {
+	return math.Round(v*100) / 100
+}
+
+func floatPtr(v float64) *float64 {
+	return &v
+}
+
+func captionDefaults(opts CaptionOpts) CaptionOpts {
+	if opts.MaxCharsPerLine <= 0 {
+		opts.MaxCharsPerLine = defaultMaxCharsPerLine
+	}
+	if opts.MaxLines <= 0 {
+		opts.MaxLines = defaultMaxLines
+	}
+	if opts.MinCueDuration <= 0 {
+		opts.MinCueDuration = defaultMinCueDuration
+	}
+	if opts.MaxCueDuration <= 0 {
+		opts.MaxCueDuration = defaultMaxCueDuration
+	}
+	return opts
+}
+
+func messageWords(message asyncinterfaces.Message, base time.Time) []timedWord {
+	speaker := message.From.Name
+
+	words := make([]timedWord, 0, len(message.Words))
+	for _, word := range message.Words {
+		text := strings.TrimSpace(word.Word)
+		if text == "" {
+			continue
+		}
+
+		tw := timedWord{
+			text:    text,
+			speaker: speaker,
+		}
+		if word.Duration > 0 {
+			tw.start = secondsToDuration(word.TimeOffset)
+			tw.end = tw.start + secondsToDuration(word.Duration)
+		} else {
+			start, errStart := time.Parse(time.RFC3339Nano, word.StartTime)
+			end, errEnd := time.Parse(time.RFC3339Nano, word.EndTime)
+			if errStart != nil || errEnd != nil || base.IsZero() {
+				// no usable timing, fall back to spreading the message text
+				return spreadWords(message, base)
+			}
+			tw.start = start.Sub(base)
+			tw.end = end.Sub(base)
+		}
+		words = append(words, tw)
+	}
+
+	if len(words) == 0 {
+		return spreadWords(message, base)
+	}
+	return words
+}
+
+// spreadWords distributes the message text evenly across the message duration
+func spreadWords(message asyncinterfaces.Message, base time.Time) []timedWord {
+	fields := strings.Fields(message.Text)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	start, end := async.MessageTiming(message, base)
+	step := (end - start) / time.Duration(len(fields))
+
+	words := make([]timedWord, len(fields))
+	for i, field := range fields {
+		words[i] = timedWord{
+			text:    field,
+			start:   start + time.Duration(i)*step,
+			end:     start + time.Duration(i+1)*step,
+			speaker: message.From.Name,
+		}
+	}
+	return words
+}
+
+func labelLines(speaker string, lines []string) []string {
+	if len(lines) == 0 {
+		return lines
+	}
+	labeled := make([]string, len(lines))
+	copy(labeled, lines)
+	labeled[0] = fmt.Sprintf("%s: %s", speaker, labeled[0])
+	return labeled
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second))
+}
+
+// formatTimestamp renders hh:mm:ss followed by the separator and milliseconds
+func formatTimestamp(d time.Duration, separator string) string {
+	if d < 0 {
+		d = 0
+	}
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d%s%03d", ms/3600000, (ms/60000)%60, (ms/1000)%60, separator, ms%1000)
+}
+
+func formatClock(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	s := int64(d / time.Second)
+	return fmt.Sprintf("%02d:%02d:%02d", s/3600, (s/60)%60, s%60)
+}
+
+// escapeTableCell keeps text on one line and escapes the column separator
+func escapeTableCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\r\n", "<br>", "\n", "<br>", "\r", "<br>").Replace(s)
+}
+
+func escapeVTT(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
+
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
@@ -0,0 +1,16 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Matcher package for evaluating trackers and custom entities against transcripts locally
+*/
+package matcher
+
+const (
+	// TrackerMatchTypeVocabulary is the match type reported for vocabulary phrases
+	TrackerMatchTypeVocabulary string = "vocabulary"
+
+	// shortest stem left when stripping word endings
+	minStemLength int = 3
+)
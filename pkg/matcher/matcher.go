@@ -0,0 +1,157 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Matcher package for evaluating trackers and custom entities against transcripts locally
+*/
+package matcher
+
+import (
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// New creates a Matcher for the tracker and custom entity definitions
+func New(trackers []mgmtinterfaces.Tracker, entities []mgmtinterfaces.Entity, options MatcherOpts) *Matcher {
+	klog.V(4).Infof("Matcher using %d trackers and %d entities\n", len(trackers), len(entities))
+	return &Matcher{
+		options:  options,
+		trackers: trackers,
+		entities: entities,
+	}
+}
+
+// MatchTrackers returns the trackers with vocabulary found in the messages, shaped like the
+// results of GetTrackers. Phrases are matched on whole words ignoring case and word endings.
+func (m *Matcher) MatchTrackers(result *asyncinterfaces.MessageResult) []asyncinterfaces.TrackerResult {
+	klog.V(6).Infof("Matcher.MatchTrackers ENTER\n")
+
+	trackers := make([]asyncinterfaces.TrackerResult, 0)
+	if result == nil {
+		klog.V(6).Infof("Matcher.MatchTrackers LEAVE\n")
+		return trackers
+	}
+
+	messages := m.tokenizeMessages(result.Messages)
+	for _, tracker := range m.trackers {
+		matches := make([]asyncinterfaces.TrackerMatch, 0)
+		for _, phrase := range tracker.Vocabulary {
+			refs := m.findPhrase(phrase, result.Messages, messages)
+			if len(refs) == 0 {
+				continue
+			}
+			matches = append(matches, asyncinterfaces.TrackerMatch{
+				Type:        TrackerMatchTypeVocabulary,
+				Value:       phrase,
+				MessageRefs: refs,
+			})
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		klog.V(4).Infof("Tracker %s matched %d phrases\n", tracker.Name, len(matches))
+		trackers = append(trackers, asyncinterfaces.TrackerResult{
+			ID:      tracker.ID,
+			Name:    tracker.Name,
+			Matches: matches,
+		})
+	}
+
+	klog.V(6).Infof("Matcher.MatchTrackers LEAVE\n")
+	return trackers
+}
+
+// MatchTrackersText matches trackers against plain text treated as a single message
+func (m *Matcher) MatchTrackersText(text string) []asyncinterfaces.TrackerResult {
+	return m.MatchTrackers(textResult(text))
+}
+
+// MatchEntities returns the custom entities with values found in the messages, shaped like the
+// results of GetEntities
+func (m *Matcher) MatchEntities(result *asyncinterfaces.MessageResult) []asyncinterfaces.Entity {
+	klog.V(6).Infof("Matcher.MatchEntities ENTER\n")
+
+	entities := make([]asyncinterfaces.Entity, 0)
+	if result == nil {
+		klog.V(6).Infof("Matcher.MatchEntities LEAVE\n")
+		return entities
+	}
+
+	messages := m.tokenizeMessages(result.Messages)
+	for _, entity := range m.entities {
+		matches := make([]asyncinterfaces.EntityMatch, 0)
+		for _, value := range entity.Values {
+			refs := m.findPhrase(value, result.Messages, messages)
+			if len(refs) == 0 {
+				continue
+			}
+			matches = append(matches, asyncinterfaces.EntityMatch{
+				DetectedValue: value,
+				MessageRefs:   refs,
+			})
+		}
+		if len(matches) == 0 {
+			continue
+		}
+
+		klog.V(4).Infof("Entity %s/%s matched %d values\n", entity.Type, entity.SubType, len(matches))
+		entities = append(entities, asyncinterfaces.Entity{
+			Type:     entity.Type,
+			SubType:  entity.SubType,
+			Category: entity.Category,
+			Matches:  matches,
+		})
+	}
+
+	klog.V(6).Infof("Matcher.MatchEntities LEAVE\n")
+	return entities
+}
+
+// MatchEntitiesText matches custom entities against plain text treated as a single message
+func (m *Matcher) MatchEntitiesText(text string) []asyncinterfaces.Entity {
+	return m.MatchEntities(textResult(text))
+}
+
+func (m *Matcher) tokenizeMessages(messages []asyncinterfaces.Message) [][]token {
+	tokens := make([][]token, len(messages))
+	for i, message := range messages {
+		tokens[i] = m.tokenize(message.Text)
+	}
+	return tokens
+}
+
+// findPhrase returns a reference for every occurrence of the phrase in the messages
+func (m *Matcher) findPhrase(phrase string, messages []asyncinterfaces.Message, tokens [][]token) []asyncinterfaces.MessageRef {
+	words := m.tokenize(phrase)
+	if len(words) == 0 {
+		return nil
+	}
+
+	refs := make([]asyncinterfaces.MessageRef, 0)
+	for i, message := range messages {
+		for _, offset := range findTokens(tokens[i], words) {
+			refs = append(refs, asyncinterfaces.MessageRef{
+				ID:        message.ID,
+				StartTime: message.StartTime,
+				EndTime:   message.EndTime,
+				Text:      message.Text,
+				Offset:    offset,
+			})
+		}
+	}
+	return refs
+}
+
+func (m *Matcher) tokenize(text string) []token {
+	tokens := splitWords(text)
+	for i := range tokens {
+		if !m.options.DisableStemming {
+			tokens[i].text = stem(tokens[i].text)
+		}
+	}
+	return tokens
+}
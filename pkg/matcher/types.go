@@ -0,0 +1,31 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Matcher package for evaluating trackers and custom entities against transcripts locally
+*/
+package matcher
+
+import (
+	mgmtinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/management/v1/interfaces"
+)
+
+// MatcherOpts defines options for the Matcher
+type MatcherOpts struct {
+	// DisableStemming only matches words which are equal ignoring case
+	DisableStemming bool
+}
+
+// Matcher finds tracker vocabulary and custom entity values in messages
+type Matcher struct {
+	options  MatcherOpts
+	trackers []mgmtinterfaces.Tracker
+	entities []mgmtinterfaces.Entity
+}
+
+// token is a normalized word and the rune offset where it starts
+type token struct {
+	text   string
+	offset int
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Matcher package for evaluating trackers and custom entities against transcripts locally
+*/
+package matcher
+
+import (
+	"strings"
+	"unicode"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+func textResult(text string) *asyncinterfaces.MessageResult {
+	return &asyncinterfaces.MessageResult{
+		Messages: []asyncinterfaces.Message{
+			{Text: text},
+		},
+	}
+}
+
+// splitWords lower cases the words in text and records the rune offset of each
+func splitWords(text string) []token {
+	tokens := make([]token, 0)
+
+	var word strings.Builder
+	start := 0
+	flush := func() {
+		if word.Len() > 0 {
+			tokens = append(tokens, token{
+				text:   strings.TrimSuffix(strings.Trim(word.String(), "'"), "'s"),
+				offset: start,
+			})
+			word.Reset()
+		}
+	}
+
+	i := 0
+	for _, r := range text {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || (r == '\'' && word.Len() > 0) {
+			if word.Len() == 0 {
+				start = i
+			}
+			word.WriteRune(unicode.ToLower(r))
+		} else {
+			flush()
+		}
+		i++
+	}
+	flush()
+
+	return tokens
+}
+
+// stem strips common English endings so that inflections of a word compare equal. It is not a
+// full stemmer but is applied the same way to phrases and messages.
+func stem(word string) string {
+	switch {
+	case len(word) > minStemLength+2 && strings.HasSuffix(word, "ies"):
+		word = word[:len(word)-3] + "y"
+	case len(word) > minStemLength && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		word = word[:len(word)-1]
+	}
+
+	switch {
+	case len(word) > minStemLength+3 && strings.HasSuffix(word, "ing"):
+		word = undouble(word[:len(word)-3])
+	case len(word) > minStemLength+2 && strings.HasSuffix(word, "ed"):
+		word = undouble(word[:len(word)-2])
+	}
+
+	if len(word) > minStemLength && strings.HasSuffix(word, "e") {
+		word = word[:len(word)-1]
+	}
+	return word
+}
+
+// undouble removes a doubled final consonant left by stripping a suffix, e.g. "stopp"
+func undouble(word string) string {
+	n := len(word)
+	if n < 2 || word[n-1] != word[n-2] {
+		return word
+	}
+	switch word[n-1] {
+	case 'a', 'e', 'i', 'o', 'u', 'l', 's', 'z':
+		return word
+	}
+	return word[:n-1]
+}
+
+// findTokens returns the offsets where words appear consecutively in tokens
+func findTokens(tokens, words []token) []int {
+	offsets := make([]int, 0)
+	for i := 0; i+len(words) <= len(tokens); i++ {
+		found := true
+		for j, word := range words {
+			if tokens[i+j].text != word.text {
+				found = false
+				break
+			}
+		}
+		if found {
+			offsets = append(offsets, tokens[i].offset)
+		}
+	}
+	return offsets
+}
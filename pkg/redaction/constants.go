@@ -0,0 +1,15 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Redaction package for removing PII detected as entities from conversation results
+*/
+package redaction
+
+const (
+	defaultReplacementToken string = "[REDACTED]"
+
+	// replaced with the entity type in the replacement token
+	typePlaceholder string = "{type}"
+)
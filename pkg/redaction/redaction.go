@@ -0,0 +1,140 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Redaction package for removing PII detected as entities from conversation results
+*/
+package redaction
+
+import (
+	"strings"
+
+	klog "k8s.io/klog/v2"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// New creates a Redactor for the entities returned by GetEntities
+func New(entities *asyncinterfaces.EntityResult, options RedactorOpts) *Redactor {
+	if options.ReplacementToken == "" {
+		options.ReplacementToken = defaultReplacementToken
+	}
+
+	r := &Redactor{
+		options: options,
+	}
+	if entities != nil {
+		for _, entity := range entities.Entities {
+			if r.selected(entity) {
+				r.entities = append(r.entities, entity)
+			}
+		}
+	}
+
+	klog.V(4).Infof("Redactor using %d entities\n", len(r.entities))
+	return r
+}
+
+// RedactMessages returns a copy of the messages with the detected entities replaced. Words of a
+// redacted message which belong to a detected value are also replaced.
+func (r *Redactor) RedactMessages(result *asyncinterfaces.MessageResult) *asyncinterfaces.MessageResult {
+	klog.V(6).Infof("Redactor.RedactMessages ENTER\n")
+
+	if result == nil {
+		klog.V(6).Infof("Redactor.RedactMessages LEAVE\n")
+		return nil
+	}
+
+	// spans and values by message ID
+	spans := make(map[string][]span)
+	values := make(map[string][]value)
+	for _, entity := range r.entities {
+		token := r.token(entity)
+		for _, match := range entity.Matches {
+			if strings.TrimSpace(match.DetectedValue) == "" {
+				continue
+			}
+			for _, ref := range match.MessageRefs {
+				spans[ref.ID] = append(spans[ref.ID], span{start: ref.Offset, token: token})
+				values[ref.ID] = append(values[ref.ID], value{text: match.DetectedValue, token: token})
+			}
+		}
+	}
+
+	redacted := &asyncinterfaces.MessageResult{
+		Messages: make([]asyncinterfaces.Message, len(result.Messages)),
+	}
+
+	count := 0
+	for i, message := range result.Messages {
+		message.Words = append(message.Words[:0:0], message.Words...)
+
+		text := applySpans(message.Text, spans[message.ID], values[message.ID])
+		if r.options.AllOccurrences {
+			text = r.RedactText(text)
+		}
+		if text != message.Text {
+			message.Text = text
+			redactWords(&message, values[message.ID], r.options.AllOccurrences, r.allValues())
+			count++
+		}
+
+		redacted.Messages[i] = message
+	}
+
+	klog.V(3).Infof("RedactMessages redacted %d messages\n", count)
+	klog.V(6).Infof("Redactor.RedactMessages LEAVE\n")
+	return redacted
+}
+
+// RedactTranscript returns a copy of the transcript with every occurrence of a detected value replaced
+func (r *Redactor) RedactTranscript(result *asyncinterfaces.TranscriptResult) *asyncinterfaces.TranscriptResult {
+	klog.V(6).Infof("Redactor.RedactTranscript ENTER\n")
+
+	if result == nil {
+		klog.V(6).Infof("Redactor.RedactTranscript LEAVE\n")
+		return nil
+	}
+
+	redacted := *result
+	redacted.Transcript.Payload = r.RedactText(result.Transcript.Payload)
+
+	klog.V(6).Infof("Redactor.RedactTranscript LEAVE\n")
+	return &redacted
+}
+
+// RedactText replaces every case insensitive occurrence of a detected value
+func (r *Redactor) RedactText(text string) string {
+	for _, v := range r.allValues() {
+		text = replaceAllFold(text, v.text, v.token)
+	}
+	return text
+}
+
+func (r *Redactor) selected(entity asyncinterfaces.Entity) bool {
+	if len(r.options.Include) > 0 && !matchesType(entity, r.options.Include) {
+		return false
+	}
+	return !matchesType(entity, r.options.Exclude)
+}
+
+func (r *Redactor) token(entity asyncinterfaces.Entity) string {
+	return strings.ReplaceAll(r.options.ReplacementToken, typePlaceholder, strings.ToUpper(entity.Type))
+}
+
+// allValues are the detected values, longest first so that overlapping values are fully replaced
+func (r *Redactor) allValues() []value {
+	values := make([]value, 0)
+	for _, entity := range r.entities {
+		token := r.token(entity)
+		for _, match := range entity.Matches {
+			if strings.TrimSpace(match.DetectedValue) == "" {
+				continue
+			}
+			values = append(values, value{text: match.DetectedValue, token: token})
+		}
+	}
+	sortValues(values)
+	return values
+}
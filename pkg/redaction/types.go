@@ -0,0 +1,46 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Redaction package for removing PII detected as entities from conversation results
+*/
+package redaction
+
+import (
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+// RedactorOpts defines options for the Redactor
+type RedactorOpts struct {
+	// Include limits redaction to these entity types. Matched against the type, sub type and category
+	Include []string
+
+	// Exclude skips these entity types. Matched against the type, sub type and category
+	Exclude []string
+
+	// ReplacementToken replaces redacted content. "{type}" is replaced by the entity type. Defaults to [REDACTED]
+	ReplacementToken string
+
+	// AllOccurrences also redacts detected values wherever they appear instead of only at the referenced offsets
+	AllOccurrences bool
+}
+
+// Redactor applies entities detected by the platform to messages and transcripts
+type Redactor struct {
+	options  RedactorOpts
+	entities []asyncinterfaces.Entity
+}
+
+// span is a range of runes in a message to replace
+type span struct {
+	start int
+	end   int
+	token string
+}
+
+// value is a detected value to replace wherever it appears
+type value struct {
+	text  string
+	token string
+}
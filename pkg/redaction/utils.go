@@ -0,0 +1,173 @@
+// Copyright 2023 Symbl.ai SDK contributors. All Rights Reserved.
+// Use of this source code is governed by an Apache-2.0 license that can be found in the LICENSE file.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+	Redaction package for removing PII detected as entities from conversation results
+*/
+package redaction
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	asyncinterfaces "github.com/symblai/symbl-go-sdk/pkg/api/async/v1/interfaces"
+)
+
+func matchesType(entity asyncinterfaces.Entity, types []string) bool {
+	for _, t := range types {
+		if strings.EqualFold(t, entity.Type) || strings.EqualFold(t, entity.SubType) || strings.EqualFold(t, entity.Category) {
+			return true
+		}
+	}
+	return false
+}
+
+// applySpans replaces the values at the referenced offsets. Offsets are in characters. When the
+// value isn't found at the offset, the occurrence closest to the offset is used.
+func applySpans(text string, spans []span, values []value) string {
+	if len(spans) == 0 {
+		return text
+	}
+
+	runes := []rune(text)
+	lower := lowerRunes(runes)
+
+	resolved := make([]span, 0, len(spans))
+	for i, s := range spans {
+		needle := lowerRunes([]rune(values[i].text))
+		start := closestIndex(lower, needle, s.start)
+		if start < 0 {
+			continue
+		}
+		resolved = append(resolved, span{start: start, end: start + len(needle), token: s.token})
+	}
+	if len(resolved) == 0 {
+		return text
+	}
+
+	sort.Slice(resolved, func(i, j int) bool {
+		return resolved[i].start < resolved[j].start
+	})
+
+	var b strings.Builder
+	pos := 0
+	for _, s := range resolved {
+		if s.end <= pos {
+			continue
+		}
+		if s.start < pos {
+			s.start = pos
+		} else {
+			b.WriteString(string(runes[pos:s.start]))
+			b.WriteString(s.token)
+		}
+		pos = s.end
+	}
+	b.WriteString(string(runes[pos:]))
+
+	return b.String()
+}
+
+// closestIndex finds the occurrence of needle in haystack closest to offset
+func closestIndex(haystack, needle []rune, offset int) int {
+	if len(needle) == 0 || len(needle) > len(haystack) {
+		return -1
+	}
+
+	best := -1
+	for i := 0; i+len(needle) <= len(haystack); i++ {
+		if !equalRunes(haystack[i:i+len(needle)], needle) {
+			continue
+		}
+		if best < 0 || abs(i-offset) < abs(best-offset) {
+			best = i
+		}
+	}
+	return best
+}
+
+func replaceAllFold(text, old, token string) string {
+	runes := []rune(text)
+	lower := lowerRunes(runes)
+	needle := lowerRunes([]rune(old))
+	if len(needle) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	pos := 0
+	for i := 0; i+len(needle) <= len(lower); {
+		if equalRunes(lower[i:i+len(needle)], needle) {
+			b.WriteString(string(runes[pos:i]))
+			b.WriteString(token)
+			i += len(needle)
+			pos = i
+			continue
+		}
+		i++
+	}
+	b.WriteString(string(runes[pos:]))
+
+	return b.String()
+}
+
+// redactWords replaces the words of a message which are part of a detected value
+func redactWords(message *asyncinterfaces.Message, values []value, allOccurrences bool, all []value) {
+	if allOccurrences {
+		values = append(values, all...)
+	}
+
+	tokens := make(map[string]string)
+	for _, v := range values {
+		for _, field := range strings.Fields(v.text) {
+			tokens[normalizeWord(field)] = v.token
+		}
+	}
+
+	for i := range message.Words {
+		if token, ok := tokens[normalizeWord(message.Words[i].Word)]; ok {
+			message.Words[i].Word = token
+		}
+	}
+}
+
+func normalizeWord(word string) string {
+	return strings.ToLower(strings.TrimFunc(word, func(r rune) bool {
+		return unicode.IsPunct(r) || unicode.IsSpace(r)
+	}))
+}
+
+func sortValues(values []value) {
+	sort.SliceStable(values, func(i, j int) bool {
+		return len([]rune(values[i].text)) > len([]rune(values[j].text))
+	})
+}
+
+func lowerRunes(runes []rune) []rune {
+	lower := make([]rune, len(runes))
+	for i, r := range runes {
+		lower[i] = unicode.ToLower(r)
+	}
+	return lower
+}
+
+func equalRunes(a, b []rune) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}